@@ -43,6 +43,9 @@ func init() {
 		bindClientFlags(rootCmd, v)
 	}
 
+	rootCmd.Flags().Bool("sonarr-live-updates", false, "receive live updates from sonarr")
+	mustBindPFlag("sonarr.live_updates", rootCmd.Flags().Lookup("sonarr-live-updates"))
+
 	rootCmd.Flags().String("logging-level", "info", "log level")
 	rootCmd.Flags().String("logging-folder", "", "log folder")
 	mustBindPFlag("logging.level", rootCmd.Flags().Lookup("logging-level"))
@@ -29,11 +29,15 @@ type SonarrConfig struct {
 	ClientConfig           `mapstructure:",squash"`
 	DefaultQualityProfile  string `mapstructure:"default_quality_profile"`
 	DefaultLanguageProfile string `mapstructure:"default_language_profile"`
+	LiveUpdates            bool   `mapstructure:"live_updates"`
 }
 
 // RadarrConfig represents the radarr config
 type RadarrConfig struct {
-	ClientConfig `mapstructure:",squash"`
+	ClientConfig               `mapstructure:",squash"`
+	DefaultQualityProfile      string `mapstructure:"default_quality_profile"`
+	DefaultRootFolder          string `mapstructure:"default_root_folder"`
+	DefaultMinimumAvailability string `mapstructure:"default_minimum_availability"`
 }
 
 // LoggingConfig represents the logging config
@@ -14,6 +14,13 @@ func TestLoadConfig(t *testing.T) {
 
 	assert.Equal(t, "https://sonarr.local/", cfg.Sonarr.Host)
 	assert.Equal(t, "123456a", cfg.Sonarr.APIKey)
+	assert.True(t, cfg.Sonarr.LiveUpdates)
+
+	assert.Equal(t, "https://radarr.local/", cfg.Radarr.Host)
+	assert.Equal(t, "123456b", cfg.Radarr.APIKey)
+	assert.Equal(t, "HD-1080p", cfg.Radarr.DefaultQualityProfile)
+	assert.Equal(t, "/movies", cfg.Radarr.DefaultRootFolder)
+	assert.Equal(t, "released", cfg.Radarr.DefaultMinimumAvailability)
 }
 
 func TestLoadNoFileConfig(t *testing.T) {
@@ -0,0 +1,63 @@
+// Package health contains the health checks shared by all clients
+package health
+
+import (
+	"path"
+	"strings"
+)
+
+// Disk is a mount reported by a client
+type Disk struct {
+	Path  string
+	Free  int64
+	Total int64
+}
+
+// Count counts the warnings and errors of the given health check types
+func Count(types []string) (warnings, errors int) {
+	for _, t := range types {
+		switch t {
+		case "warning":
+			warnings++
+		case "error":
+			errors++
+		}
+	}
+	return warnings, errors
+}
+
+// LowestFreeSpace returns the lowest free space in percent of the disks the folders are on.
+// If the disk of no folder is known, -1 is returned.
+func LowestFreeSpace(folders []string, disks []Disk) float64 {
+	lowest := -1.0
+	for _, folder := range folders {
+		// the disk of a folder is the mount with the longest path containing the folder
+		var disk *Disk
+		for i, d := range disks {
+			if d.Total <= 0 || !isSubPath(d.Path, folder) {
+				continue
+			}
+			if disk == nil || len(d.Path) > len(disk.Path) {
+				disk = &disks[i]
+			}
+		}
+		if disk == nil {
+			continue
+		}
+		free := float64(disk.Free) / float64(disk.Total) * 100
+		if lowest < 0 || free < lowest {
+			lowest = free
+		}
+	}
+	return lowest
+}
+
+// isSubPath reports whether p is the same as or inside of parent
+func isSubPath(parent, p string) bool {
+	parent = path.Clean(strings.ReplaceAll(parent, "\\", "/"))
+	p = path.Clean(strings.ReplaceAll(p, "\\", "/"))
+	if parent == "/" || parent == p {
+		return true
+	}
+	return strings.HasPrefix(p, strings.TrimSuffix(parent, "/")+"/")
+}
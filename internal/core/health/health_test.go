@@ -0,0 +1,34 @@
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCount(t *testing.T) {
+	warnings, errors := Count([]string{"ok", "warning", "error", "notice", "warning"})
+	assert.Equal(t, 2, warnings)
+	assert.Equal(t, 1, errors)
+}
+
+func TestLowestFreeSpace(t *testing.T) {
+	disks := []Disk{
+		{Path: "/", Free: 10, Total: 100},
+		{Path: "/data", Free: 50, Total: 100},
+		{Path: "/data/tv", Free: 80, Total: 100},
+		{Path: `D:\`, Free: 30, Total: 100},
+		{Path: "/empty", Free: 0, Total: 0},
+	}
+
+	assert.Equal(t, -1.0, LowestFreeSpace(nil, disks))
+	assert.Equal(t, -1.0, LowestFreeSpace([]string{"/tv"}, nil))
+
+	// the folder is on the mount with the longest matching path
+	assert.Equal(t, 80.0, LowestFreeSpace([]string{"/data/tv/"}, disks))
+	assert.Equal(t, 50.0, LowestFreeSpace([]string{"/data/tv2", "/data/tv/anime"}, disks))
+	assert.Equal(t, 10.0, LowestFreeSpace([]string{"/tv", "/data/tv"}, disks))
+	assert.Equal(t, 30.0, LowestFreeSpace([]string{`D:\Series`}, disks))
+	// mounts without a size are ignored
+	assert.Equal(t, 10.0, LowestFreeSpace([]string{"/empty/tv"}, disks))
+}
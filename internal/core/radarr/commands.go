@@ -0,0 +1,61 @@
+package radarr
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/radarr"
+)
+
+func (c *Client) doCommandRequest(req *radarr.CommandRequest) (*radarr.CommandResource, error) { // nolint:unparam
+	res, err := c.radarr.PostCommand(context.Background(), req)
+	if err != nil {
+		logging.Log.Error("Failed to send command", "err", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) AutomaticSearchMovie() tea.Cmd {
+	return func() tea.Msg {
+		if c.movie == nil {
+			logging.Log.Error(ErrNoMovieSelected)
+			return ErrNoMovieSelected
+		}
+
+		req := radarr.CommandRequest{
+			Name:     "MoviesSearch",
+			MovieIDs: []int32{c.movie.ID},
+		}
+		_, err := c.doCommandRequest(&req)
+		return err
+	}
+}
+
+func (c *Client) RefreshMovie() tea.Cmd {
+	return func() tea.Msg {
+		if c.movie == nil {
+			logging.Log.Error(ErrNoMovieSelected)
+			return ErrNoMovieSelected
+		}
+
+		req := radarr.CommandRequest{
+			Name:     "RefreshMovie",
+			MovieIDs: []int32{c.movie.ID},
+		}
+		_, err := c.doCommandRequest(&req)
+		return err
+	}
+}
+
+// RunTask runs a scheduled task immediately
+func (c *Client) RunTask(taskName string) tea.Cmd {
+	return func() tea.Msg {
+		req := radarr.CommandRequest{
+			Name: taskName,
+		}
+		_, err := c.doCommandRequest(&req)
+		return err
+	}
+}
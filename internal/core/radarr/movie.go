@@ -0,0 +1,95 @@
+package radarr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/radarr"
+)
+
+var ErrNoMovieSelected = errors.New("no movie selected")
+
+type FetchMovieResult struct {
+	Movie *radarr.MovieResource
+	Error error
+}
+
+// ReloadMovie fetches the selected movie together with its files and history
+func (c *Client) ReloadMovie() tea.Cmd {
+	return func() tea.Msg {
+		// if currently no movie is selected, return error
+		if c.movie == nil {
+			logging.Log.Error(ErrNoMovieSelected)
+			return FetchMovieResult{Error: ErrNoMovieSelected}
+		}
+
+		movie, err := c.radarr.GetMovie(context.Background(), c.movie.ID)
+		if err != nil {
+			logging.Log.Error("Failed to reload movie", "err", err)
+			return FetchMovieResult{Movie: c.movie, Error: fmt.Errorf("Failed to reload movie")} //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+
+		files, err := c.radarr.GetMovieFiles(context.Background(), movie.ID)
+		if err != nil {
+			logging.Log.Error("Failed to fetch movie files", "err", err)
+			return FetchMovieResult{Movie: c.movie, Error: fmt.Errorf("Failed to fetch movie files")} //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+
+		history, err := c.radarr.GetMovieHistory(context.Background(), movie.ID)
+		if err != nil {
+			logging.Log.Error("Failed to fetch movie history", "err", err)
+			return FetchMovieResult{Movie: c.movie, Error: fmt.Errorf("Failed to fetch movie history")} //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+
+		c.setMovie(movie)
+		c.movieFiles = files
+		c.movieHistory = history
+		return FetchMovieResult{Movie: movie}
+	}
+}
+
+func (c *Client) ToggleMonitorMovie() tea.Cmd {
+	return func() tea.Msg {
+		if c.movie == nil {
+			logging.Log.Error(ErrNoMovieSelected)
+			return FetchMovieResult{Error: ErrNoMovieSelected}
+		}
+
+		c.movie.Monitored = !c.movie.Monitored
+		movie, err := c.radarr.PutMovie(context.Background(), c.movie)
+		if err != nil {
+			c.movie.Monitored = !c.movie.Monitored
+			logging.Log.Error("Failed to toggle movie monitored state", "err", err)
+			return FetchMovieResult{Movie: c.movie, Error: fmt.Errorf("Failed to toggle movie monitored state")} //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+		c.setMovie(movie)
+		return FetchMovieResult{Movie: movie}
+	}
+}
+
+// setMovie sets the selected movie and replaces it in the movie list
+func (c *Client) setMovie(movie *radarr.MovieResource) {
+	qp := c.GetQualityProfileByID(movie.QualityProfileID)
+	if qp != nil {
+		movie.ProfileName = qp.Name
+	}
+	movie.Title = sanitizeTitle(movie.Title)
+	movie.Overview = sanitizeOverview(movie.Overview)
+
+	for i, m := range c.movies {
+		if m.ID == movie.ID {
+			c.movies[i] = movie
+			break
+		}
+	}
+	c.movie = movie
+}
+
+// MovieItems returns the list items of all movies
+func (c *Client) MovieItems() []list.Item {
+	return c.newMovieItems()
+}
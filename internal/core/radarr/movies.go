@@ -0,0 +1,160 @@
+package radarr
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/radarr"
+)
+
+type FetchMoviesResult struct {
+	Items []list.Item
+	Error error
+}
+
+type AddMovieResult struct {
+	AddedTitle string
+	Items      []list.Item
+	Error      error
+}
+
+type DeleteMovieResult struct {
+	DeletedTitle string
+	Items        []list.Item
+	Error        error
+}
+
+type MovieItem struct {
+	Movie *radarr.MovieResource
+}
+
+func (m MovieItem) FilterValue() string {
+	return m.Movie.Title
+}
+
+func (c *Client) FetchMovies() tea.Cmd {
+	return func() tea.Msg {
+		if err := c.fetchMovies(); err != nil {
+			logging.Log.Error("Failed to fetch movies", "err", err)
+			return FetchMoviesResult{Error: err}
+		}
+		return FetchMoviesResult{Items: c.newMovieItems()}
+	}
+}
+
+func (c *Client) fetchMovies() error {
+	movies, err := c.radarr.GetMovies(context.Background())
+	if err != nil {
+		logging.Log.Error("Failed to fetch movies", "err", err)
+		return err
+	}
+
+	// Add the quality profile name to the movies
+	for i := range movies {
+		qp := c.GetQualityProfileByID(movies[i].QualityProfileID)
+		if qp != nil {
+			movies[i].ProfileName = qp.Name
+		}
+	}
+
+	// Sanitize movies
+	sanitizeMovieResources(movies)
+
+	sortMovies(movies)
+	c.movies = movies
+
+	return nil
+}
+
+// newMovieItems creates a list item for each movie
+func (c *Client) newMovieItems() []list.Item {
+	var items []list.Item
+	for _, m := range c.movies {
+		items = append(items, MovieItem{m})
+	}
+	return items
+}
+
+// sortMovies sorts the movies by their sort title
+func sortMovies(movies []*radarr.MovieResource) {
+	sort.Slice(movies, func(i, j int) bool {
+		return movies[i].SortTitle < movies[j].SortTitle
+	})
+}
+
+func sanitizeMovieResources(movies []*radarr.MovieResource) {
+	for i := range movies {
+		movies[i].Title = sanitizeTitle(movies[i].Title)
+		movies[i].Overview = sanitizeOverview(movies[i].Overview)
+	}
+}
+
+// sanitizeTitle replaces all unicode whitespace characters with a single space.
+func sanitizeTitle(s string) string {
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			s = strings.Replace(s, string(r), " ", -1)
+		}
+	}
+	return s
+}
+
+var punctuationRe = regexp.MustCompile(`([,.:])(\S)`)
+
+// sanitizeOverview removes all newline and tab characters from the overview.
+func sanitizeOverview(s string) string {
+	s = strings.ReplaceAll(s, "\n", "")
+	s = strings.ReplaceAll(s, "\t", " ")
+	s = strings.ReplaceAll(s, "\r", "")
+
+	res := punctuationRe.ReplaceAllString(s, "$1 $2")
+	return strings.TrimSpace(res)
+}
+
+func (c *Client) PostMovie(movie *radarr.MovieResource) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := c.radarr.PostMovie(context.Background(), movie)
+		if err != nil {
+			logging.Log.Error("Failed to add movie", "err", err)
+			return AddMovieResult{Error: err}
+		}
+		qp := c.GetQualityProfileByID(resp.QualityProfileID)
+		if qp != nil {
+			resp.ProfileName = qp.Name
+		}
+		c.movies = append(c.movies, resp)
+		sortMovies(c.movies)
+		return AddMovieResult{
+			AddedTitle: movie.Title,
+			Items:      c.newMovieItems(),
+		}
+	}
+}
+
+func (c *Client) DeleteMovie(movie *radarr.MovieResource, deleteFiles, addExclusion bool) tea.Cmd {
+	return func() tea.Msg {
+		if err := c.radarr.DeleteMovie(context.Background(), movie.ID, deleteFiles, addExclusion); err != nil {
+			logging.Log.Error("Failed to delete movie", "err", err)
+			return DeleteMovieResult{
+				DeletedTitle: movie.Title,
+				Error:        err,
+			}
+		}
+		for i, m := range c.movies {
+			if m.ID == movie.ID {
+				c.movies = append(c.movies[:i], c.movies[i+1:]...)
+				break
+			}
+		}
+		return DeleteMovieResult{
+			DeletedTitle: movie.Title,
+			Items:        c.newMovieItems(),
+		}
+	}
+}
@@ -1,20 +1,39 @@
 package radarr
 
 import (
+	"context"
+	"strings"
+
 	"github.com/jon4hz/submarr/internal/config"
+	"github.com/jon4hz/submarr/internal/logging"
 	"github.com/jon4hz/submarr/pkg/radarr"
 )
 
 type Client struct {
 	Config *config.RadarrConfig
 	radarr *radarr.Client
-
 	// is the client available?
 	available bool
-
-	// some client stats
-	missing int
-	queued  int
+	// number of missing movies
+	totalMissing int32
+	// number of items in the download queue
+	totalQueued int32
+	// quality profiles by id
+	qualityProfiles []*radarr.QualityProfileResource
+	// all available root folders
+	rootFolders []*radarr.RootFolderResource
+	// all available movies
+	movies []*radarr.MovieResource
+	// currently selected movie
+	movie *radarr.MovieResource
+	// files of the currently selected movie
+	movieFiles []*radarr.MovieFileResource
+	// history of the currently selected movie
+	movieHistory []*radarr.HistoryResource
+	// health issues reported by radarr
+	health []*radarr.HealthResource
+	// disk space of all mounts
+	diskSpace []*radarr.DiskSpaceResource
 }
 
 func New(cfg *config.RadarrConfig, radarr *radarr.Client) *Client {
@@ -27,9 +46,11 @@ func New(cfg *config.RadarrConfig, radarr *radarr.Client) *Client {
 	}
 }
 
+// Init initializes the client and fetches some stats
 func (c *Client) Init() error {
-	/* ping, err := c.radarr.Ping(context.Background())
+	ping, err := c.radarr.Ping(context.Background())
 	if err != nil {
+		logging.Log.Error("Failed to ping radarr", "err", err)
 		return err
 	}
 	if strings.ToLower(ping.Status) == "ok" {
@@ -38,16 +59,162 @@ func (c *Client) Init() error {
 		return nil
 	}
 
+	collectors := []func() error{
+		c.FetchQueueNumber,
+		c.FetchMissingNumber,
+		c.FetchQualityProfiles,
+		c.FetchRootFolders,
+	}
+	for _, collector := range collectors {
+		if err := collector(); err != nil {
+			return err
+		}
+	}
+
+	// the health and the disk space are only shown on the tile, the client is usable without them
+	_ = c.FetchHealth()
+	_ = c.FetchDiskSpace()
+
+	return nil
+}
+
+// FetchQueueNumber fetches the number of items in the download queue
+func (c *Client) FetchQueueNumber() error {
 	queue, err := c.radarr.GetQueue(context.Background())
 	if err != nil {
+		c.available = false
+		logging.Log.Error("Failed to get queue", "err", err)
 		return err
 	}
-	c.queued = int(queue.TotalRecords) */
+
+	if queue != nil {
+		c.totalQueued = queue.TotalRecords
+	}
+
+	return nil
+}
+
+// FetchMissingNumber fetches the number of missing movies
+func (c *Client) FetchMissingNumber() error {
+	missing, err := c.radarr.GetMissing(context.Background())
+	if err != nil {
+		c.available = false
+		logging.Log.Error("Failed to get missing movies", "err", err)
+		return err
+	}
+
+	if missing != nil {
+		c.totalMissing = missing.TotalRecords
+	}
+
+	return nil
+}
+
+// FetchQualityProfiles fetches all quality profiles
+func (c *Client) FetchQualityProfiles() error {
+	profiles, err := c.radarr.GetQualityProfiles(context.Background())
+	if err != nil {
+		logging.Log.Error("Failed to fetch quality profiles", "err", err)
+		return err
+	}
+
+	if len(profiles) == 0 {
+		logging.Log.Warn("No quality profiles found")
+		return nil
+	}
+
+	c.qualityProfiles = profiles
+
+	return nil
+}
+
+// FetchRootFolders fetches all root folders
+func (c *Client) FetchRootFolders() error {
+	folders, err := c.radarr.GetRootFolders(context.Background())
+	if err != nil {
+		logging.Log.Error("Failed to fetch root folders", "err", err)
+		return err
+	}
+
+	if len(folders) == 0 {
+		logging.Log.Warn("No root folders found")
+		return nil
+	}
+
+	c.rootFolders = folders
+
+	return nil
+}
+
+// FetchHealth fetches the health issues
+func (c *Client) FetchHealth() error {
+	health, err := c.radarr.GetHealth(context.Background())
+	if err != nil {
+		logging.Log.Error("Failed to fetch health", "err", err)
+		c.health = nil
+		return err
+	}
+
+	if health == nil {
+		// an empty slice means there are no issues, nil means the health is unknown
+		health = make([]*radarr.HealthResource, 0)
+	}
+	c.health = health
+
+	return nil
+}
+
+// FetchDiskSpace fetches the disk space of all mounts
+func (c *Client) FetchDiskSpace() error {
+	disks, err := c.radarr.GetDiskSpace(context.Background())
+	if err != nil {
+		logging.Log.Error("Failed to fetch disk space", "err", err)
+		c.diskSpace = nil
+		return err
+	}
+
+	c.diskSpace = disks
 
 	return nil
-	//return errors.New("test")
 }
 
 func (c *Client) ClientListItem() ClientItem {
 	return ClientItem{c}
 }
+
+func (c *Client) SetMovie(movie *radarr.MovieResource) {
+	c.movie = movie
+}
+
+func (c *Client) GetMovie() *radarr.MovieResource {
+	return c.movie
+}
+
+func (c *Client) GetMovieFiles() []*radarr.MovieFileResource {
+	return c.movieFiles
+}
+
+func (c *Client) GetMovieHistory() []*radarr.HistoryResource {
+	return c.movieHistory
+}
+
+func (c *Client) GetQualityProfiles() []*radarr.QualityProfileResource {
+	return c.qualityProfiles
+}
+
+func (c *Client) GetRootFolders() []*radarr.RootFolderResource {
+	return c.rootFolders
+}
+
+// GetQualityProfileByID returns a quality profile by id or an empty quality profile if not found
+func (c *Client) GetQualityProfileByID(id int32) *radarr.QualityProfileResource {
+	if c.qualityProfiles == nil {
+		return nil
+	}
+	for _, profile := range c.qualityProfiles {
+		if profile.ID == id {
+			return profile
+		}
+	}
+	return new(radarr.QualityProfileResource)
+}
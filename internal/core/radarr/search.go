@@ -0,0 +1,86 @@
+package radarr
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/radarr"
+)
+
+type SearchMoviesResult struct {
+	Items []list.Item
+	Error error
+}
+
+// SearchMovies looks up movies by a search term.
+// The term can also be a tmdb id (tmdb:####) or an imdb id (imdb:tt####, tt####).
+func (c *Client) SearchMovies(term string) (tea.Cmd, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return func() tea.Msg {
+		res, err := c.lookupMovies(ctx, term)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			logging.Log.Error("Failed to search movies", "err", err)
+			return SearchMoviesResult{Error: err}
+		}
+
+		// Sanitize movies
+		sanitizeMovieResources(res)
+
+		var items []list.Item
+		for _, m := range res {
+			items = append(items, MovieItem{m})
+		}
+		return SearchMoviesResult{Items: items}
+	}, cancel
+}
+
+func (c *Client) lookupMovies(ctx context.Context, term string) ([]*radarr.MovieResource, error) {
+	lower := strings.ToLower(term)
+	switch {
+	case strings.HasPrefix(lower, "tmdb:"):
+		id, err := strconv.ParseInt(strings.TrimSpace(term[len("tmdb:"):]), 10, 32)
+		if err != nil {
+			return nil, errors.New("Invalid tmdb id") //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+		movie, err := c.radarr.GetMovieLookupTMDB(ctx, int32(id))
+		if err != nil {
+			return nil, err
+		}
+		return []*radarr.MovieResource{movie}, nil
+
+	case strings.HasPrefix(lower, "imdb:"), strings.HasPrefix(lower, "tt"):
+		id := strings.TrimPrefix(strings.TrimSpace(strings.TrimPrefix(lower, "imdb:")), "tt")
+		if !isDigits(id) {
+			// not an imdb id after all, e.g. "tt" as start of a title
+			return c.radarr.GetMovieLookup(ctx, term)
+		}
+		movie, err := c.radarr.GetMovieLookupIMDB(ctx, "tt"+id)
+		if err != nil {
+			return nil, err
+		}
+		return []*radarr.MovieResource{movie}, nil
+	}
+
+	return c.radarr.GetMovieLookup(ctx, term)
+}
+
+// isDigits reports whether s is a non-empty string of ascii digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
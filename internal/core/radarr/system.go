@@ -0,0 +1,58 @@
+package radarr
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/radarr"
+)
+
+type FetchSystemResult struct {
+	Status    *radarr.SystemResource
+	Health    []*radarr.HealthResource
+	DiskSpace []*radarr.DiskSpaceResource
+	Tasks     []*radarr.TaskResource
+	Error     error
+}
+
+// FetchSystem fetches the status, the health issues, the disk space and the scheduled tasks
+func (c *Client) FetchSystem() tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		status, err := c.radarr.GetSystemStatus(ctx)
+		if err != nil {
+			logging.Log.Error("Failed to fetch system status", "err", err)
+			return FetchSystemResult{Error: err}
+		}
+		health, err := c.radarr.GetHealth(ctx)
+		if err != nil {
+			logging.Log.Error("Failed to fetch health", "err", err)
+			return FetchSystemResult{Error: err}
+		}
+		disks, err := c.radarr.GetDiskSpace(ctx)
+		if err != nil {
+			logging.Log.Error("Failed to fetch disk space", "err", err)
+			return FetchSystemResult{Error: err}
+		}
+		sort.SliceStable(disks, func(i, j int) bool {
+			return disks[i].Path < disks[j].Path
+		})
+		tasks, err := c.radarr.GetTasks(ctx)
+		if err != nil {
+			logging.Log.Error("Failed to fetch tasks", "err", err)
+			return FetchSystemResult{Error: err}
+		}
+		sort.SliceStable(tasks, func(i, j int) bool {
+			return strings.ToLower(tasks[i].Name) < strings.ToLower(tasks[j].Name)
+		})
+		return FetchSystemResult{
+			Status:    status,
+			Health:    health,
+			DiskSpace: disks,
+			Tasks:     tasks,
+		}
+	}
+}
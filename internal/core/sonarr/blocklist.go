@@ -0,0 +1,127 @@
+package sonarr
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+// BlocklistPageSize is the number of blocklist items fetched per page
+const BlocklistPageSize = 20
+
+type FetchBlocklistResult struct {
+	Blocklist *sonarr.BlocklistResourcePagingResource
+	// Episodes holds the blocklisted episodes by id
+	Episodes map[int32]*sonarr.EpisodeResource
+	Error    error
+}
+
+type DeleteBlocklistResult struct {
+	Count int
+	// All is true if the whole blocklist was cleared
+	All   bool
+	Error error
+}
+
+// FetchBlocklist fetches a page of the blocklist, most recent items first.
+// The blocklist only references the episodes and unlike the history, it can't include them.
+// So the episodes of the page are fetched as well, with one request per series.
+func (c *Client) FetchBlocklist(page int) tea.Cmd {
+	return func() tea.Msg {
+		blocklist, err := c.sonarr.GetBlocklist(context.Background(),
+			httpclient.WithPage(page),
+			httpclient.WithPageSize(BlocklistPageSize),
+			httpclient.WithSortKey("date"),
+			httpclient.WithSortDirection(httpclient.Descending),
+		)
+		if err != nil {
+			logging.Log.Error("Failed to fetch blocklist", "err", err)
+			return FetchBlocklistResult{Error: err}
+		}
+
+		// group the episodes of the page by series, so every series is only requested once
+		var seriesIDs []int32
+		episodeIDs := make(map[int32][]int32)
+		for _, item := range blocklist.Records {
+			if _, ok := episodeIDs[item.SeriesID]; !ok {
+				seriesIDs = append(seriesIDs, item.SeriesID)
+			}
+			episodeIDs[item.SeriesID] = append(episodeIDs[item.SeriesID], item.EpisodeIDs...)
+		}
+
+		episodes := make(map[int32]*sonarr.EpisodeResource)
+		for _, seriesID := range seriesIDs {
+			seriesEpisodes, err := c.sonarr.GetAllEpisodes(context.Background(), seriesID)
+			if err != nil {
+				// the blocklist is still useful without the episode numbers
+				logging.Log.Error("Failed to fetch episodes", "series", seriesID, "err", err)
+				continue
+			}
+			// only keep the episodes which are on the page
+			byID := make(map[int32]*sonarr.EpisodeResource, len(seriesEpisodes))
+			for _, e := range seriesEpisodes {
+				byID[e.ID] = e
+			}
+			for _, id := range episodeIDs[seriesID] {
+				if e, ok := byID[id]; ok {
+					episodes[id] = e
+				}
+			}
+		}
+
+		return FetchBlocklistResult{Blocklist: blocklist, Episodes: episodes}
+	}
+}
+
+// DeleteBlocklistItems removes the given items from the blocklist
+func (c *Client) DeleteBlocklistItems(ids []int32) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if len(ids) == 1 {
+			err = c.sonarr.DeleteBlocklistItem(context.Background(), ids[0])
+		} else {
+			err = c.sonarr.DeleteBlocklistItems(context.Background(), ids)
+		}
+		if err != nil {
+			logging.Log.Error("Failed to delete blocklist items", "ids", ids, "err", err)
+			return DeleteBlocklistResult{Count: len(ids), Error: err}
+		}
+		return DeleteBlocklistResult{Count: len(ids)}
+	}
+}
+
+// ClearBlocklist removes all items from the blocklist
+func (c *Client) ClearBlocklist() tea.Cmd {
+	return func() tea.Msg {
+		// the first request only fetches the number of items
+		blocklist, err := c.sonarr.GetBlocklist(context.Background(), httpclient.WithPageSize(1))
+		if err != nil {
+			logging.Log.Error("Failed to fetch blocklist", "err", err)
+			return DeleteBlocklistResult{All: true, Error: err}
+		}
+		if blocklist.TotalRecords == 0 {
+			return DeleteBlocklistResult{All: true}
+		}
+
+		blocklist, err = c.sonarr.GetBlocklist(context.Background(), httpclient.WithPageSize(int(blocklist.TotalRecords)))
+		if err != nil {
+			logging.Log.Error("Failed to fetch blocklist", "err", err)
+			return DeleteBlocklistResult{All: true, Error: err}
+		}
+		if len(blocklist.Records) == 0 {
+			return DeleteBlocklistResult{All: true}
+		}
+		ids := make([]int32, len(blocklist.Records))
+		for i, item := range blocklist.Records {
+			ids[i] = item.ID
+		}
+		if err := c.sonarr.DeleteBlocklistItems(context.Background(), ids); err != nil {
+			logging.Log.Error("Failed to clear blocklist", "err", err)
+			return DeleteBlocklistResult{Count: len(ids), All: true, Error: err}
+		}
+		return DeleteBlocklistResult{Count: len(ids), All: true}
+	}
+}
@@ -0,0 +1,51 @@
+package sonarr
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charmbracelet/log"
+	"github.com/jon4hz/submarr/internal/config"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchBlocklist(t *testing.T) {
+	logging.Log = log.New(io.Discard)
+
+	requests := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path+"?"+r.URL.Query().Get("seriesId")]++
+		switch r.URL.Path {
+		case "/api/v3/blocklist":
+			_, _ = w.Write([]byte(`{"page":1,"pageSize":20,"totalRecords":3,"records":[{"id":1,"seriesId":1,"episodeIds":[1]},{"id":2,"seriesId":2,"episodeIds":[3]},{"id":3,"seriesId":1,"episodeIds":[2]}]}`))
+		case "/api/v3/episode":
+			if r.URL.Query().Get("seriesId") == "1" {
+				_, _ = w.Write([]byte(`[{"id":1,"seriesId":1},{"id":2,"seriesId":1},{"id":4,"seriesId":1}]`))
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.SonarrConfig{}
+	cfg.Host = srv.URL
+	c := New(cfg, sonarr.New(httpclient.New(), cfg))
+
+	res, ok := c.FetchBlocklist(1)().(FetchBlocklistResult)
+	assert.True(t, ok)
+	assert.NoError(t, res.Error)
+	assert.Len(t, res.Blocklist.Records, 3)
+
+	// every series is only requested once and only the episodes on the page are kept
+	assert.Equal(t, 1, requests["/api/v3/episode?1"])
+	assert.Equal(t, 1, requests["/api/v3/episode?2"])
+	assert.Len(t, res.Episodes, 2)
+	assert.Contains(t, res.Episodes, int32(1))
+	assert.Contains(t, res.Episodes, int32(2))
+}
@@ -0,0 +1,56 @@
+package sonarr
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type FetchCalendarResult struct {
+	Start    time.Time
+	Episodes []*sonarr.EpisodeResource
+	// ids of the episodes which are currently in the download queue
+	Queued map[int32]bool
+	Error  error
+}
+
+// FetchCalendar fetches the episodes airing between start and end, sorted by their air date.
+// The download queue is fetched as well to tell which episodes are currently downloading.
+func (c *Client) FetchCalendar(start, end time.Time, unmonitored bool) tea.Cmd {
+	return func() tea.Msg {
+		episodes, err := c.sonarr.GetCalendar(context.Background(), start, end, unmonitored)
+		if err != nil {
+			logging.Log.Error("Failed to fetch calendar", "err", err)
+			return FetchCalendarResult{Start: start, Error: err}
+		}
+		sort.SliceStable(episodes, func(i, j int) bool {
+			return episodes[i].AirDateUTC.Before(episodes[j].AirDateUTC)
+		})
+
+		queue, err := c.sonarr.GetQueue(context.Background(),
+			httpclient.WithPage(1),
+			httpclient.WithPageSize(1000),
+		)
+		if err != nil {
+			logging.Log.Error("Failed to fetch queue", "err", err)
+			return FetchCalendarResult{Start: start, Error: err}
+		}
+		c.totalQueued = queue.TotalRecords
+
+		queued := make(map[int32]bool, len(queue.Records))
+		for _, item := range queue.Records {
+			queued[item.EpisodeID] = true
+		}
+
+		return FetchCalendarResult{
+			Start:    start,
+			Episodes: episodes,
+			Queued:   queued,
+		}
+	}
+}
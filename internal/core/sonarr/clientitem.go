@@ -3,6 +3,7 @@ package sonarr
 import (
 	"fmt"
 
+	"github.com/jon4hz/submarr/internal/core/health"
 	"github.com/jon4hz/submarr/internal/tui/common"
 )
 
@@ -24,3 +25,30 @@ func (i ClientItem) Stats() []string {
 		fmt.Sprintf("%d missing", i.c.totalMissing),
 	}
 }
+
+// Health returns the number of health warnings and errors.
+// ok is false if the health couldn't be fetched.
+func (i ClientItem) Health() (warnings, errors int, ok bool) {
+	if i.c.health == nil {
+		return 0, 0, false
+	}
+	types := make([]string, len(i.c.health))
+	for j, h := range i.c.health {
+		types[j] = string(h.Type)
+	}
+	warnings, errors = health.Count(types)
+	return warnings, errors, true
+}
+
+// LowestFreeSpace returns the lowest free space in percent of the disks the root folders are on
+func (i ClientItem) LowestFreeSpace() float64 {
+	folders := make([]string, len(i.c.rootFolders))
+	for j, f := range i.c.rootFolders {
+		folders[j] = f.Path
+	}
+	disks := make([]health.Disk, len(i.c.diskSpace))
+	for j, d := range i.c.diskSpace {
+		disks[j] = health.Disk{Path: d.Path, Free: d.FreeSpace, Total: d.TotalSpace}
+	}
+	return health.LowestFreeSpace(folders, disks)
+}
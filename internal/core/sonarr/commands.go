@@ -3,6 +3,9 @@ package sonarr
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/jon4hz/submarr/internal/logging"
@@ -11,13 +14,66 @@ import (
 
 var ErrNoEpisodes = errors.New("no episodes provided")
 
-func (c *Client) doCommandRequest(req *sonarr.CommandRequest) (*sonarr.CommandResource, error) { // nolint:unparam
+// CommandPollInterval is the interval in which the state of a running command is polled
+const CommandPollInterval = 2 * time.Second
+
+// CommandUpdateMsg is sent whenever a command was started or its state was polled
+type CommandUpdateMsg struct {
+	ID      int32
+	Command *sonarr.CommandResource
+	Error   error
+}
+
+func (c *Client) doCommandRequest(req *sonarr.CommandRequest) tea.Msg {
 	res, err := c.sonarr.PostCommand(context.Background(), req)
 	if err != nil {
 		logging.Log.Error("Failed to send command", "err", err)
-		return nil, err
+		return err
 	}
-	return res, nil
+	return CommandUpdateMsg{ID: res.ID, Command: res}
+}
+
+// PollCommand fetches the state of the command after the poll interval
+func (c *Client) PollCommand(commandID int32) tea.Cmd {
+	return tea.Tick(CommandPollInterval, func(time.Time) tea.Msg {
+		res, err := c.sonarr.GetCommand(context.Background(), commandID)
+		if err != nil {
+			logging.Log.Error("Failed to fetch command", "err", err, "id", commandID)
+			return CommandUpdateMsg{ID: commandID, Error: err}
+		}
+		return CommandUpdateMsg{ID: commandID, Command: res}
+	})
+}
+
+// IsCommandFinished returns true if the command won't change its state anymore
+func IsCommandFinished(command *sonarr.CommandResource) bool {
+	switch command.Status {
+	case sonarr.CommandStatusCompleted,
+		sonarr.CommandStatusFailed,
+		sonarr.CommandStatusAborted,
+		sonarr.CommandStatusCancelled,
+		sonarr.CommandStatusOrphaned:
+		return true
+	}
+	return false
+}
+
+var reportsDownloadedRegex = regexp.MustCompile(`(\d+) reports? downloaded`)
+
+// CommandSummary returns a short summary of the command state, e.g. "SeriesSearch: completed, 3 grabbed"
+func CommandSummary(command *sonarr.CommandResource) string {
+	summary := fmt.Sprintf("%s: %s", command.Name, command.Status)
+	switch command.Status {
+	case sonarr.CommandStatusCompleted:
+		if match := reportsDownloadedRegex.FindStringSubmatch(command.Message); match != nil {
+			summary += fmt.Sprintf(", %s grabbed", match[1])
+		}
+	case sonarr.CommandStatusFailed:
+		if command.Message != "" {
+			summary += fmt.Sprintf(", %s", command.Message)
+		}
+	}
+	return summary
 }
 
 func (c *Client) AutomaticSearchEpisode(epiodeIDs ...int32) tea.Cmd {
@@ -30,8 +86,7 @@ func (c *Client) AutomaticSearchEpisode(epiodeIDs ...int32) tea.Cmd {
 			Name:       "EpisodeSearch",
 			EpisodeIDs: epiodeIDs,
 		}
-		_, err := c.doCommandRequest(&req)
-		return err
+		return c.doCommandRequest(&req)
 	}
 }
 
@@ -46,9 +101,24 @@ func (c *Client) AutomaticSearchSeries() tea.Cmd {
 			Name:     "SeriesSearch",
 			SeriesID: c.serie.ID,
 		}
-		_, err := c.doCommandRequest(&req)
-		return err
+		return c.doCommandRequest(&req)
+	}
+}
+
+// BulkSearchSeries searches for all monitored episodes of the given series.
+// Sonarr only accepts a single series per search, so a command is started for each of them.
+func (c *Client) BulkSearchSeries(seriesIDs ...int32) tea.Cmd {
+	cmds := make([]tea.Cmd, len(seriesIDs))
+	for i, id := range seriesIDs {
+		req := sonarr.CommandRequest{
+			Name:     "SeriesSearch",
+			SeriesID: id,
+		}
+		cmds[i] = func() tea.Msg {
+			return c.doCommandRequest(&req)
+		}
 	}
+	return tea.Batch(cmds...)
 }
 
 func (c *Client) AutomaticSearchSeason(seasonNumber int32) tea.Cmd {
@@ -63,8 +133,7 @@ func (c *Client) AutomaticSearchSeason(seasonNumber int32) tea.Cmd {
 			SeasonNumber: seasonNumber,
 			SeriesID:     c.serie.ID,
 		}
-		_, err := c.doCommandRequest(&req)
-		return err
+		return c.doCommandRequest(&req)
 	}
 }
 
@@ -79,7 +148,50 @@ func (c *Client) RefreshSeries() tea.Cmd {
 			Name:     "RefreshSeries",
 			SeriesID: c.serie.ID,
 		}
-		_, err := c.doCommandRequest(&req)
-		return err
+		return c.doCommandRequest(&req)
+	}
+}
+
+// RescanSeries scans the disk for new or removed files of the selected serie
+func (c *Client) RescanSeries() tea.Cmd {
+	return func() tea.Msg {
+		if c.serie == nil {
+			logging.Log.Error(ErrNoSerieSelected)
+			return ErrNoSerieSelected
+		}
+
+		req := sonarr.CommandRequest{
+			Name:     "RescanSeries",
+			SeriesID: c.serie.ID,
+		}
+		return c.doCommandRequest(&req)
+	}
+}
+
+func (c *Client) AutomaticSearchMissing() tea.Cmd {
+	return func() tea.Msg {
+		req := sonarr.CommandRequest{
+			Name: "MissingEpisodeSearch",
+		}
+		return c.doCommandRequest(&req)
+	}
+}
+
+func (c *Client) AutomaticSearchCutoffUnmet() tea.Cmd {
+	return func() tea.Msg {
+		req := sonarr.CommandRequest{
+			Name: "CutoffUnmetEpisodeSearch",
+		}
+		return c.doCommandRequest(&req)
+	}
+}
+
+// RunTask runs a scheduled task immediately
+func (c *Client) RunTask(taskName string) tea.Cmd {
+	return func() tea.Msg {
+		req := sonarr.CommandRequest{
+			Name: taskName,
+		}
+		return c.doCommandRequest(&req)
 	}
 }
@@ -0,0 +1,143 @@
+package sonarr
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type FetchEpisodeFilesResult struct {
+	Files []*sonarr.EpisodeFileResource
+	Error error
+}
+
+type FetchEpisodeFileOptionsResult struct {
+	Qualities []*sonarr.Quality
+	Languages []*sonarr.Language
+	Error     error
+}
+
+type DeleteEpisodeFilesResult struct {
+	Count int
+	Error error
+}
+
+type EditEpisodeFilesResult struct {
+	Count int
+	Error error
+}
+
+// FetchEpisodeFiles fetches all episode files of the selected serie, sorted by season and path
+func (c *Client) FetchEpisodeFiles() tea.Cmd {
+	return func() tea.Msg {
+		if c.serie == nil {
+			logging.Log.Error(ErrNoSerieSelected)
+			return FetchEpisodeFilesResult{Error: ErrNoSerieSelected}
+		}
+		files, err := c.sonarr.GetEpisodeFiles(context.Background(), c.serie.ID)
+		if err != nil {
+			logging.Log.Error("Failed to fetch episode files", "series", c.serie.Title, "err", err)
+			return FetchEpisodeFilesResult{Error: err}
+		}
+		sort.SliceStable(files, func(i, j int) bool {
+			if files[i].SeasonNumber != files[j].SeasonNumber {
+				return files[i].SeasonNumber < files[j].SeasonNumber
+			}
+			return strings.ToLower(files[i].RelativePath) < strings.ToLower(files[j].RelativePath)
+		})
+		return FetchEpisodeFilesResult{Files: files}
+	}
+}
+
+// FetchEpisodeFileOptions fetches the qualities and languages an episode file can be set to
+func (c *Client) FetchEpisodeFileOptions() tea.Cmd {
+	return func() tea.Msg {
+		definitions, err := c.sonarr.GetQualityDefinitions(context.Background())
+		if err != nil {
+			logging.Log.Error("Failed to fetch quality definitions", "err", err)
+			return FetchEpisodeFileOptionsResult{Error: err}
+		}
+		// the best quality comes first
+		sort.SliceStable(definitions, func(i, j int) bool {
+			return definitions[i].Weight > definitions[j].Weight
+		})
+		qualities := make([]*sonarr.Quality, 0, len(definitions))
+		for _, definition := range definitions {
+			if definition.Quality != nil {
+				qualities = append(qualities, definition.Quality)
+			}
+		}
+
+		languages, err := c.sonarr.GetLanguages(context.Background())
+		if err != nil {
+			logging.Log.Error("Failed to fetch languages", "err", err)
+			return FetchEpisodeFileOptionsResult{Error: err}
+		}
+
+		return FetchEpisodeFileOptionsResult{
+			Qualities: qualities,
+			Languages: languages,
+		}
+	}
+}
+
+// DeleteEpisodeFiles deletes the given episode files from the disk
+func (c *Client) DeleteEpisodeFiles(fileIDs []int32) tea.Cmd {
+	return func() tea.Msg {
+		if err := c.sonarr.DeleteEpisodeFiles(context.Background(), fileIDs); err != nil {
+			logging.Log.Error("Failed to delete episode files", "ids", fileIDs, "err", err)
+			return DeleteEpisodeFilesResult{Count: len(fileIDs), Error: err}
+		}
+		return DeleteEpisodeFilesResult{Count: len(fileIDs)}
+	}
+}
+
+// EditEpisodeFiles sets the quality and the languages of the given episode files.
+// A nil quality or empty languages keep the current values.
+// The revision of a file, e.g. of a proper, is kept when its quality changes.
+func (c *Client) EditEpisodeFiles(files []*sonarr.EpisodeFileResource, quality *sonarr.Quality, languages []sonarr.Language) tea.Cmd {
+	return func() tea.Msg {
+		fileIDs := make([]int32, len(files))
+		for i, file := range files {
+			fileIDs[i] = file.ID
+		}
+
+		editors := []*sonarr.EpisodeFileListResource{{EpisodeFileIDs: fileIDs, Languages: languages}}
+		if quality != nil {
+			// the editor sets the same quality model for all files, so the files are grouped by revision
+			editors = nil
+			byRevision := make(map[sonarr.Revision]*sonarr.EpisodeFileListResource)
+			for _, file := range files {
+				revision := sonarr.Revision{Version: 1}
+				if file.Quality != nil && file.Quality.Revision != nil {
+					revision = *file.Quality.Revision
+				}
+				editor, ok := byRevision[revision]
+				if !ok {
+					editor = &sonarr.EpisodeFileListResource{
+						Languages: languages,
+						Quality: &sonarr.QualityModel{
+							Quality:  quality,
+							Revision: &revision,
+						},
+					}
+					byRevision[revision] = editor
+					editors = append(editors, editor)
+				}
+				editor.EpisodeFileIDs = append(editor.EpisodeFileIDs, file.ID)
+			}
+		}
+
+		for _, editor := range editors {
+			if err := c.sonarr.PutEpisodeFileEditor(context.Background(), editor); err != nil {
+				logging.Log.Error("Failed to edit episode files", "ids", editor.EpisodeFileIDs, "err", err)
+				return EditEpisodeFilesResult{Count: len(fileIDs), Error: err}
+			}
+		}
+		return EditEpisodeFilesResult{Count: len(fileIDs)}
+	}
+}
@@ -0,0 +1,57 @@
+package sonarr
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charmbracelet/log"
+	"github.com/jon4hz/submarr/internal/config"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditEpisodeFiles(t *testing.T) {
+	logging.Log = log.New(io.Discard)
+
+	var editors []sonarr.EpisodeFileListResource
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var editor sonarr.EpisodeFileListResource
+		if err := json.NewDecoder(r.Body).Decode(&editor); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		editors = append(editors, editor)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.SonarrConfig{}
+	cfg.Host = srv.URL
+	c := New(cfg, sonarr.New(httpclient.New(), cfg))
+
+	proper := &sonarr.Revision{Version: 2}
+	files := []*sonarr.EpisodeFileResource{
+		{ID: 1, Quality: &sonarr.QualityModel{Revision: &sonarr.Revision{Version: 1}}},
+		{ID: 2, Quality: &sonarr.QualityModel{Revision: proper}},
+		{ID: 3, Quality: &sonarr.QualityModel{Revision: &sonarr.Revision{Version: 1}}},
+	}
+	quality := &sonarr.Quality{ID: 7}
+
+	res, ok := c.EditEpisodeFiles(files, quality, nil)().(EditEpisodeFilesResult)
+	assert.True(t, ok)
+	assert.NoError(t, res.Error)
+	assert.Equal(t, 3, res.Count)
+
+	// the files are edited once per revision, which is kept
+	require.Len(t, editors, 2)
+	assert.Equal(t, []int32{1, 3}, editors[0].EpisodeFileIDs)
+	assert.Equal(t, int32(1), editors[0].Quality.Revision.Version)
+	assert.Equal(t, []int32{2}, editors[1].EpisodeFileIDs)
+	assert.Equal(t, *proper, *editors[1].Quality.Revision)
+	assert.Equal(t, int32(7), editors[1].Quality.Quality.ID)
+}
@@ -0,0 +1,190 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+// HistoryPageSize is the number of history records fetched per page
+const HistoryPageSize = 20
+
+// HistoryFilter narrows down the history records
+type HistoryFilter struct {
+	// EventType is empty to include all events
+	EventType sonarr.EpisodeHistoryEventType
+	// SeriesID is zero to include all series
+	SeriesID int32
+	// From and To limit the date of the records, zero values are unbounded. To is exclusive.
+	From time.Time
+	To   time.Time
+}
+
+// IsDateRange returns true if the filter limits the date of the records
+func (f HistoryFilter) IsDateRange() bool {
+	return !f.From.IsZero() || !f.To.IsZero()
+}
+
+type FetchHistoryResult struct {
+	History *sonarr.HistoryResourcePagingResource
+	Error   error
+}
+
+type MarkHistoryFailedResult struct {
+	Title string
+	Error error
+}
+
+// FetchHistory fetches a page of the history, most recent records first.
+// The paging endpoint of sonarr can only filter by event type,
+// so records filtered by series or start date are fetched at once and paged here.
+func (c *Client) FetchHistory(filter HistoryFilter, page int) tea.Cmd {
+	return func() tea.Msg {
+		if filter.SeriesID == 0 && !filter.IsDateRange() {
+			history, err := c.getHistoryPage(filter.EventType, page)
+			if err != nil {
+				logging.Log.Error("Failed to fetch history", "err", err)
+				return FetchHistoryResult{Error: err}
+			}
+			return FetchHistoryResult{History: history}
+		}
+
+		if filter.SeriesID == 0 && filter.From.IsZero() {
+			history, err := c.getHistoryPageBefore(filter.To, filter.EventType, page)
+			if err != nil {
+				logging.Log.Error("Failed to fetch history", "err", err)
+				return FetchHistoryResult{Error: err}
+			}
+			return FetchHistoryResult{History: history}
+		}
+
+		var (
+			records []*sonarr.HistoryResource
+			err     error
+		)
+		if filter.SeriesID != 0 {
+			records, err = c.sonarr.GetSeriesHistory(context.Background(), filter.SeriesID, filter.EventType)
+		} else {
+			records, err = c.sonarr.GetHistorySince(context.Background(), filter.From, filter.EventType)
+		}
+		if err != nil {
+			logging.Log.Error("Failed to fetch history", "err", err)
+			return FetchHistoryResult{Error: err}
+		}
+
+		return FetchHistoryResult{History: pageHistory(filterHistory(records, filter), page)}
+	}
+}
+
+// getHistoryPage fetches a page of the history from the paging endpoint
+func (c *Client) getHistoryPage(eventType sonarr.EpisodeHistoryEventType, page int) (*sonarr.HistoryResourcePagingResource, error) {
+	params := map[string]string{
+		"includeSeries":  "true",
+		"includeEpisode": "true",
+	}
+	if eventType != "" {
+		params["eventType"] = strconv.Itoa(eventType.ID())
+	}
+	return c.sonarr.GetHistory(context.Background(),
+		httpclient.WithPage(page),
+		httpclient.WithPageSize(HistoryPageSize),
+		httpclient.WithSortKey("date"),
+		httpclient.WithSortDirection(httpclient.Descending),
+		httpclient.WithParams(params),
+	)
+}
+
+// getHistoryPageBefore fetches a page of the history records before the given date.
+// Only the records since the date are fetched at once, they are skipped on the paging endpoint.
+func (c *Client) getHistoryPageBefore(date time.Time, eventType sonarr.EpisodeHistoryEventType, page int) (*sonarr.HistoryResourcePagingResource, error) {
+	newer, err := c.sonarr.GetHistorySince(context.Background(), date, eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	// the page usually spans two pages of the endpoint
+	offset := len(newer) + max(page-1, 0)*HistoryPageSize
+	first := offset/HistoryPageSize + 1
+	history, err := c.getHistoryPage(eventType, first)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		history = &sonarr.HistoryResourcePagingResource{}
+	}
+	records := history.Records
+	if offset%HistoryPageSize != 0 && len(records) == HistoryPageSize {
+		next, err := c.getHistoryPage(eventType, first+1)
+		if err != nil {
+			return nil, err
+		}
+		if next != nil {
+			records = append(records, next.Records...)
+		}
+	}
+
+	start := min(offset%HistoryPageSize, len(records))
+	end := min(start+HistoryPageSize, len(records))
+	return &sonarr.HistoryResourcePagingResource{
+		Page:          int32(page),
+		PageSize:      HistoryPageSize,
+		SortKey:       "date",
+		SortDirection: httpclient.Descending,
+		TotalRecords:  max(history.TotalRecords-int32(len(newer)), 0),
+		Records:       records[start:end],
+	}, nil
+}
+
+func filterHistory(records []*sonarr.HistoryResource, filter HistoryFilter) []*sonarr.HistoryResource {
+	filtered := make([]*sonarr.HistoryResource, 0, len(records))
+	for _, record := range records {
+		if !filter.From.IsZero() && record.Date.Before(filter.From) {
+			continue
+		}
+		if !filter.To.IsZero() && !record.Date.Before(filter.To) {
+			continue
+		}
+		filtered = append(filtered, record)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Date.After(filtered[j].Date)
+	})
+	return filtered
+}
+
+func pageHistory(records []*sonarr.HistoryResource, page int) *sonarr.HistoryResourcePagingResource {
+	start := min(max(page-1, 0)*HistoryPageSize, len(records))
+	end := min(start+HistoryPageSize, len(records))
+	return &sonarr.HistoryResourcePagingResource{
+		Page:          int32(page),
+		PageSize:      HistoryPageSize,
+		SortKey:       "date",
+		SortDirection: httpclient.Descending,
+		TotalRecords:  int32(len(records)),
+		Records:       records[start:end],
+	}
+}
+
+// MarkHistoryFailed marks the release of a grabbed record as failed, so sonarr searches for another release
+func (c *Client) MarkHistoryFailed(record *sonarr.HistoryResource) tea.Cmd {
+	return func() tea.Msg {
+		if record.EventType != sonarr.EpisodeHistoryEventTypeGrabbed {
+			return MarkHistoryFailedResult{
+				Title: record.SourceTitle,
+				Error: fmt.Errorf("Only grabbed releases can be marked as failed"), //lint:ignore ST1005 Error will be displayed in the status bar
+			}
+		}
+		if err := c.sonarr.MarkHistoryFailed(context.Background(), record.ID); err != nil {
+			logging.Log.Error("Failed to mark history as failed", "id", record.ID, "err", err)
+			return MarkHistoryFailedResult{Title: record.SourceTitle, Error: err}
+		}
+		return MarkHistoryFailedResult{Title: record.SourceTitle}
+	}
+}
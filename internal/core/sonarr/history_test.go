@@ -0,0 +1,71 @@
+package sonarr
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/jon4hz/submarr/internal/config"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchHistoryBefore(t *testing.T) {
+	logging.Log = log.New(io.Discard)
+
+	// 45 records, one per hour, the most recent first
+	now := time.Now().Truncate(time.Hour)
+	records := make([]*sonarr.HistoryResource, 45)
+	for i := range records {
+		records[i] = &sonarr.HistoryResource{ID: int32(i + 1), Date: now.Add(-time.Duration(i) * time.Hour)}
+	}
+	to := records[3].Date.Add(time.Minute)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/history/since":
+			_ = json.NewEncoder(w).Encode(records[:3])
+		case "/api/v3/history":
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			start := min((page-1)*HistoryPageSize, len(records))
+			end := min(start+HistoryPageSize, len(records))
+			_ = json.NewEncoder(w).Encode(sonarr.HistoryResourcePagingResource{
+				Page:         int32(page),
+				PageSize:     HistoryPageSize,
+				TotalRecords: int32(len(records)),
+				Records:      records[start:end],
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.SonarrConfig{}
+	cfg.Host = srv.URL
+	c := New(cfg, sonarr.New(httpclient.New(), cfg))
+
+	res, ok := c.FetchHistory(HistoryFilter{To: to}, 1)().(FetchHistoryResult)
+	require.True(t, ok)
+	require.NoError(t, res.Error)
+	assert.Equal(t, int32(42), res.History.TotalRecords)
+	require.Len(t, res.History.Records, HistoryPageSize)
+	assert.Equal(t, int32(4), res.History.Records[0].ID)
+	assert.Equal(t, int32(23), res.History.Records[HistoryPageSize-1].ID)
+
+	// the last page only has the remaining records
+	res, ok = c.FetchHistory(HistoryFilter{To: to}, 3)().(FetchHistoryResult)
+	require.True(t, ok)
+	require.NoError(t, res.Error)
+	require.Len(t, res.History.Records, 2)
+	assert.Equal(t, int32(44), res.History.Records[0].ID)
+	assert.Equal(t, int32(45), res.History.Records[1].ID)
+}
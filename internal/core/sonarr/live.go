@@ -0,0 +1,274 @@
+package sonarr
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+var (
+	// liveBackoffMin and liveBackoffMax limit the time to wait before reconnecting to the hub
+	liveBackoffMin = time.Second
+	liveBackoffMax = time.Minute
+	// livePollInterval is the interval in which the views are refreshed while the hub isn't reachable
+	livePollInterval = 30 * time.Second
+	// liveThrottle is the time events are collected before they are sent, so a burst of events results in a single update
+	liveThrottle = time.Second
+)
+
+// LiveUpdateMsg contains the events pushed by sonarr.
+// If Refresh is set, events might have been missed and all views should be refreshed.
+type LiveUpdateMsg struct {
+	Events  []*sonarr.SignalRMessage
+	Refresh bool
+}
+
+// Affects returns true if the update contains an event with one of the given names
+func (m LiveUpdateMsg) Affects(names ...string) bool {
+	if m.Refresh {
+		return true
+	}
+	for _, event := range m.Events {
+		if isEvent(event, names...) {
+			return true
+		}
+	}
+	return false
+}
+
+// AffectsSeries returns true if the update contains an event with one of the given names which concerns the given series.
+// Events without a resource, e.g. sync events, affect every series.
+func (m LiveUpdateMsg) AffectsSeries(seriesID int32, names ...string) bool {
+	if m.Refresh {
+		return true
+	}
+	for _, event := range m.Events {
+		if !isEvent(event, names...) {
+			continue
+		}
+		if len(event.Body.Resource) == 0 {
+			return true
+		}
+		var resource struct {
+			ID       int32 `json:"id"`
+			SeriesID int32 `json:"seriesId"`
+		}
+		if err := json.Unmarshal(event.Body.Resource, &resource); err != nil {
+			return true
+		}
+		if event.Name == sonarr.SignalREventSeries {
+			resource.SeriesID = resource.ID
+		}
+		if resource.SeriesID == 0 || resource.SeriesID == seriesID {
+			return true
+		}
+	}
+	return false
+}
+
+func isEvent(event *sonarr.SignalRMessage, names ...string) bool {
+	for _, name := range names {
+		if event.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Commands returns the commands of all command events
+func (m LiveUpdateMsg) Commands() []*sonarr.CommandResource {
+	var commands []*sonarr.CommandResource
+	for _, event := range m.Events {
+		if event.Name != sonarr.SignalREventCommand || len(event.Body.Resource) == 0 {
+			continue
+		}
+		var command sonarr.CommandResource
+		if err := json.Unmarshal(event.Body.Resource, &command); err != nil {
+			logging.Log.Error("Failed to decode command event", "err", err)
+			continue
+		}
+		commands = append(commands, &command)
+	}
+	return commands
+}
+
+// LiveStatusMsg is sent when the connection to the hub was established or lost
+type LiveStatusMsg struct {
+	Connected bool
+	Error     error
+}
+
+type liveUpdates struct {
+	cancel context.CancelFunc
+	msgs   chan tea.Msg
+}
+
+// StartLiveUpdates connects to the signalr hub of sonarr in the background.
+// The returned command waits for the first message, use WaitForLiveUpdate to receive the next ones.
+func (c *Client) StartLiveUpdates() tea.Cmd {
+	c.liveMu.Lock()
+	defer c.liveMu.Unlock()
+	if c.live != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.live = &liveUpdates{
+		cancel: cancel,
+		msgs:   make(chan tea.Msg),
+	}
+	go c.runLiveUpdates(ctx, c.live.msgs)
+
+	return waitForLiveUpdate(c.live.msgs)
+}
+
+// StopLiveUpdates closes the connection to the hub
+func (c *Client) StopLiveUpdates() {
+	c.liveMu.Lock()
+	defer c.liveMu.Unlock()
+	if c.live == nil {
+		return
+	}
+	c.live.cancel()
+	c.live = nil
+}
+
+// WaitForLiveUpdate waits for the next live update
+func (c *Client) WaitForLiveUpdate() tea.Cmd {
+	c.liveMu.Lock()
+	defer c.liveMu.Unlock()
+	if c.live == nil {
+		return nil
+	}
+	return waitForLiveUpdate(c.live.msgs)
+}
+
+func waitForLiveUpdate(msgs <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		// the channel is closed when the live updates are stopped, which results in a nil msg
+		return <-msgs
+	}
+}
+
+func (c *Client) runLiveUpdates(ctx context.Context, msgs chan<- tea.Msg) {
+	defer close(msgs)
+
+	backoff := liveBackoffMin
+	reconnect := false
+	for {
+		conn, err := c.sonarr.ConnectSignalR(ctx)
+		if err == nil {
+			backoff = liveBackoffMin
+			if !sendLiveMsg(ctx, msgs, LiveStatusMsg{Connected: true}) {
+				conn.Close()
+				return
+			}
+			// we might have missed some events while we were disconnected
+			if reconnect && !sendLiveMsg(ctx, msgs, LiveUpdateMsg{Refresh: true}) {
+				conn.Close()
+				return
+			}
+			err = receiveLiveUpdates(ctx, conn, msgs)
+			conn.Close()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		reconnect = true
+
+		logging.Log.Error("Lost connection to the sonarr hub", "err", err, "retry", backoff)
+		if !sendLiveMsg(ctx, msgs, LiveStatusMsg{Error: err}) {
+			return
+		}
+		if !pollLiveUpdates(ctx, msgs, backoff) {
+			return
+		}
+		backoff = min(backoff*2, liveBackoffMax)
+	}
+}
+
+// receiveLiveUpdates reads the events from the hub until the connection is lost
+func receiveLiveUpdates(ctx context.Context, conn *sonarr.SignalRConn, msgs chan<- tea.Msg) error {
+	events := make(chan *sonarr.SignalRMessage)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			event, err := conn.Read()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		pending []*sonarr.SignalRMessage
+		flush   <-chan time.Time
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			// closing the connection unblocks the reader
+			conn.Close()
+			return ctx.Err()
+
+		case err := <-errs:
+			// don't lose the events received right before the connection was closed
+			if len(pending) > 0 {
+				sendLiveMsg(ctx, msgs, LiveUpdateMsg{Events: pending})
+			}
+			return err
+
+		case event := <-events:
+			pending = append(pending, event)
+			if flush == nil {
+				flush = time.After(liveThrottle)
+			}
+
+		case <-flush:
+			if !sendLiveMsg(ctx, msgs, LiveUpdateMsg{Events: pending}) {
+				return ctx.Err()
+			}
+			pending, flush = nil, nil
+		}
+	}
+}
+
+// pollLiveUpdates asks the views to refresh periodically until it's time to reconnect
+func pollLiveUpdates(ctx context.Context, msgs chan<- tea.Msg, backoff time.Duration) bool {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	ticker := time.NewTicker(livePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		case <-ticker.C:
+			if !sendLiveMsg(ctx, msgs, LiveUpdateMsg{Refresh: true}) {
+				return false
+			}
+		}
+	}
+}
+
+func sendLiveMsg(ctx context.Context, msgs chan<- tea.Msg, msg tea.Msg) bool {
+	select {
+	case msgs <- msg:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
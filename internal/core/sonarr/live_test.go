@@ -0,0 +1,157 @@
+package sonarr
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+	"github.com/gorilla/websocket"
+	"github.com/jon4hz/submarr/internal/config"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+const rs = "\x1e"
+
+func event(name string) string {
+	return `{"type":1,"target":"receiveMessage","arguments":[{"name":"` + name + `","body":{"action":"updated","resource":{"id":1,"name":"RefreshSeries","status":"started"}}}]}` + rs
+}
+
+// newFakeHub starts a fake signalr hub.
+// The first connection is dropped after sending two events, the second one is kept open.
+func newFakeHub(t *testing.T) *httptest.Server {
+	var connections int32
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		if err := conn.WriteMessage(websocket.TextMessage, []byte("{}"+rs)); err != nil {
+			return
+		}
+
+		switch atomic.AddInt32(&connections, 1) {
+		case 1:
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(event(sonarr.SignalREventQueue)))
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(event(sonarr.SignalREventCommand)))
+			return
+
+		default:
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(event(sonarr.SignalREventEpisode)))
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestLiveUpdates(t *testing.T) {
+	logging.Log = log.New(io.Discard)
+	liveBackoffMin = 50 * time.Millisecond
+	livePollInterval = 10 * time.Millisecond
+	liveThrottle = 20 * time.Millisecond
+
+	srv := newFakeHub(t)
+	cfg := &config.SonarrConfig{}
+	cfg.Host = srv.URL
+	c := New(cfg, sonarr.New(httpclient.New(), cfg))
+
+	next := func(cmd tea.Cmd) tea.Msg {
+		done := make(chan tea.Msg)
+		go func() { done <- cmd() }()
+		select {
+		case msg := <-done:
+			return msg
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout while waiting for live update")
+			return nil
+		}
+	}
+
+	msg := next(c.StartLiveUpdates())
+	assert.Equal(t, LiveStatusMsg{Connected: true}, msg)
+	// live updates are only started once
+	assert.Nil(t, c.StartLiveUpdates())
+
+	// both events are sent at once
+	update, ok := next(c.WaitForLiveUpdate()).(LiveUpdateMsg)
+	assert.True(t, ok)
+	assert.False(t, update.Refresh)
+	assert.Len(t, update.Events, 2)
+	assert.True(t, update.Affects(sonarr.SignalREventQueue))
+	assert.False(t, update.Affects(sonarr.SignalREventSeries))
+	assert.True(t, update.AffectsSeries(1, sonarr.SignalREventQueue))
+	commands := update.Commands()
+	if assert.Len(t, commands, 1) {
+		assert.Equal(t, "RefreshSeries", commands[0].Name)
+		assert.Equal(t, sonarr.CommandStatusStarted, commands[0].Status)
+	}
+
+	// the hub dropped the connection
+	status, ok := next(c.WaitForLiveUpdate()).(LiveStatusMsg)
+	assert.True(t, ok)
+	assert.False(t, status.Connected)
+	assert.Error(t, status.Error)
+
+	// the views are refreshed periodically until the connection is back
+	var refreshed int
+	for {
+		msg := next(c.WaitForLiveUpdate())
+		if msg == (LiveStatusMsg{Connected: true}) {
+			break
+		}
+		update, ok := msg.(LiveUpdateMsg)
+		assert.True(t, ok)
+		assert.True(t, update.Refresh)
+		assert.True(t, update.Affects(sonarr.SignalREventSeries))
+		refreshed++
+	}
+	assert.Greater(t, refreshed, 0)
+
+	// after reconnecting, the views are refreshed once
+	update, ok = next(c.WaitForLiveUpdate()).(LiveUpdateMsg)
+	assert.True(t, ok)
+	assert.True(t, update.Refresh)
+
+	update, ok = next(c.WaitForLiveUpdate()).(LiveUpdateMsg)
+	assert.True(t, ok)
+	assert.False(t, update.Refresh)
+	assert.True(t, update.Affects(sonarr.SignalREventEpisode))
+
+	wait := c.WaitForLiveUpdate()
+	c.StopLiveUpdates()
+	assert.Nil(t, next(wait))
+	assert.Nil(t, c.WaitForLiveUpdate())
+}
+
+func TestLiveUpdateAffectsSeries(t *testing.T) {
+	update := LiveUpdateMsg{
+		Events: []*sonarr.SignalRMessage{
+			{Name: sonarr.SignalREventSeries, Body: sonarr.SignalRMessageBody{Action: "updated", Resource: []byte(`{"id":1}`)}},
+			{Name: sonarr.SignalREventEpisode, Body: sonarr.SignalRMessageBody{Action: "updated", Resource: []byte(`{"id":10,"seriesId":2}`)}},
+			{Name: sonarr.SignalREventQueue, Body: sonarr.SignalRMessageBody{Action: "sync"}},
+		},
+	}
+	assert.True(t, update.AffectsSeries(1, sonarr.SignalREventSeries))
+	assert.False(t, update.AffectsSeries(2, sonarr.SignalREventSeries))
+	assert.True(t, update.AffectsSeries(2, sonarr.SignalREventEpisode))
+	assert.False(t, update.AffectsSeries(1, sonarr.SignalREventEpisode, sonarr.SignalREventEpisodeFile))
+	assert.True(t, update.AffectsSeries(3, sonarr.SignalREventQueue))
+	assert.True(t, LiveUpdateMsg{Refresh: true}.AffectsSeries(3, sonarr.SignalREventEpisode))
+}
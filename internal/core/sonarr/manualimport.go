@@ -0,0 +1,107 @@
+package sonarr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+var ErrNoImportFiles = errors.New("No files selected") //lint:ignore ST1005 Error will be displayed in the status bar
+
+type FetchManualImportResult struct {
+	Files []*sonarr.ManualImportResource
+	Error error
+}
+
+type FetchImportEpisodesResult struct {
+	SeriesID     int32
+	SeasonNumber int32
+	Episodes     []*sonarr.EpisodeResource
+	Error        error
+}
+
+// FetchManualImport fetches the files which can be imported manually.
+// The files are either looked up by the download id of a queue item or by a folder.
+func (c *Client) FetchManualImport(downloadID, folder string) tea.Cmd {
+	return func() tea.Msg {
+		params := map[string]string{
+			"filterExistingFiles": "true",
+		}
+		if downloadID != "" {
+			params["downloadId"] = downloadID
+		}
+		if folder != "" {
+			params["folder"] = folder
+		}
+		files, err := c.sonarr.GetManualImport(context.Background(), httpclient.WithParams(params))
+		if err != nil {
+			logging.Log.Error("Failed to fetch manual import", "downloadId", downloadID, "folder", folder, "err", err)
+			return FetchManualImportResult{Error: err}
+		}
+		return FetchManualImportResult{Files: files}
+	}
+}
+
+// FetchImportEpisodes fetches the episodes of a season, which a file can be mapped to
+func (c *Client) FetchImportEpisodes(seriesID, seasonNumber int32) tea.Cmd {
+	return func() tea.Msg {
+		episodes, err := c.sonarr.GetEpisodes(context.Background(), seriesID, seasonNumber)
+		if err != nil {
+			logging.Log.Error("Failed to fetch episodes", "seriesId", seriesID, "season", seasonNumber, "err", err)
+			return FetchImportEpisodesResult{Error: err}
+		}
+		return FetchImportEpisodesResult{
+			SeriesID:     seriesID,
+			SeasonNumber: seasonNumber,
+			Episodes:     episodes,
+		}
+	}
+}
+
+// ManualImport imports the files with their mapped series and episodes
+func (c *Client) ManualImport(files []*sonarr.ManualImportResource, mode sonarr.ImportMode) tea.Cmd {
+	return func() tea.Msg {
+		if len(files) == 0 {
+			return ErrNoImportFiles
+		}
+
+		req := sonarr.ManualImportCommandRequest{
+			Name:       "ManualImport",
+			Files:      make([]*sonarr.ManualImportFile, 0, len(files)),
+			ImportMode: mode,
+		}
+		for _, file := range files {
+			if file.Series == nil || len(file.Episodes) == 0 {
+				//lint:ignore ST1005 Error will be displayed in the status bar
+				return fmt.Errorf("Select a series and episodes for %s", filepath.Base(file.Path))
+			}
+			episodeIDs := make([]int32, len(file.Episodes))
+			for i, episode := range file.Episodes {
+				episodeIDs[i] = episode.ID
+			}
+			req.Files = append(req.Files, &sonarr.ManualImportFile{
+				Path:         file.Path,
+				FolderName:   file.FolderName,
+				SeriesID:     file.Series.ID,
+				EpisodeIDs:   episodeIDs,
+				Quality:      file.Quality,
+				Languages:    file.Languages,
+				ReleaseGroup: file.ReleaseGroup,
+				DownloadID:   file.DownloadID,
+			})
+		}
+
+		res, err := c.sonarr.PostManualImportCommand(context.Background(), &req)
+		if err != nil {
+			logging.Log.Error("Failed to start manual import", "err", err)
+			return err
+		}
+		return CommandUpdateMsg{ID: res.ID, Command: res}
+	}
+}
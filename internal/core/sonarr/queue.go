@@ -0,0 +1,90 @@
+package sonarr
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+// QueuePageSize is the number of queue items fetched per page
+const QueuePageSize = 20
+
+var ErrNotPending = errors.New("Only pending items can be grabbed") //lint:ignore ST1005 Error will be displayed in the status bar
+
+type FetchQueueResult struct {
+	Queue *sonarr.QueueResourcePagingResource
+	Error error
+}
+
+type RemoveQueueItemResult struct {
+	Title     string
+	Blocklist bool
+	Error     error
+}
+
+type GrabQueueItemResult struct {
+	Title string
+	Error error
+}
+
+// FetchQueue fetches a page of the download queue, including the series and episode of each item
+func (c *Client) FetchQueue(page int) tea.Cmd {
+	return func() tea.Msg {
+		queue, err := c.sonarr.GetQueue(context.Background(),
+			httpclient.WithPage(page),
+			httpclient.WithPageSize(QueuePageSize),
+			httpclient.WithSortKey("timeleft"),
+			httpclient.WithSortDirection(httpclient.Ascending),
+			httpclient.WithParams(map[string]string{
+				"includeSeries":  "true",
+				"includeEpisode": "true",
+			}),
+		)
+		if err != nil {
+			logging.Log.Error("Failed to fetch queue", "err", err)
+			return FetchQueueResult{Error: err}
+		}
+		c.totalQueued = queue.TotalRecords
+		return FetchQueueResult{Queue: queue}
+	}
+}
+
+// RemoveQueueItem removes an item from the queue and the download client.
+// If blocklist is true, the release is added to the blocklist.
+func (c *Client) RemoveQueueItem(item *sonarr.QueueResource, blocklist bool) tea.Cmd {
+	return func() tea.Msg {
+		if err := c.sonarr.DeleteQueueItem(context.Background(), item.ID, true, blocklist); err != nil {
+			logging.Log.Error("Failed to remove queue item", "err", err)
+			return RemoveQueueItemResult{Title: item.Title, Blocklist: blocklist, Error: err}
+		}
+		return RemoveQueueItemResult{Title: item.Title, Blocklist: blocklist}
+	}
+}
+
+// GrabQueueItem forces the download of a pending queue item
+func (c *Client) GrabQueueItem(item *sonarr.QueueResource) tea.Cmd {
+	return func() tea.Msg {
+		if !IsPendingQueueItem(item) {
+			return GrabQueueItemResult{Title: item.Title, Error: ErrNotPending}
+		}
+		if err := c.sonarr.GrabQueueItem(context.Background(), item.ID); err != nil {
+			logging.Log.Error("Failed to grab queue item", "err", err)
+			return GrabQueueItemResult{Title: item.Title, Error: err}
+		}
+		return GrabQueueItemResult{Title: item.Title}
+	}
+}
+
+// IsPendingQueueItem returns true if the item wasn't sent to the download client yet
+func IsPendingQueueItem(item *sonarr.QueueResource) bool {
+	switch strings.ToLower(item.Status) {
+	case "delay", "downloadclientunavailable":
+		return true
+	}
+	return false
+}
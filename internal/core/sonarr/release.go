@@ -0,0 +1,67 @@
+package sonarr
+
+import (
+	"context"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type FetchReleasesResult struct {
+	Releases []*sonarr.ReleaseResource
+	Error    error
+}
+
+type GrabReleaseResult struct {
+	Title string
+	Error error
+}
+
+// FetchEpisodeReleases searches all indexers for releases of an episode
+func (c *Client) FetchEpisodeReleases(episode *sonarr.EpisodeResource) tea.Cmd {
+	return func() tea.Msg {
+		releases, err := c.sonarr.GetReleases(context.Background(),
+			httpclient.WithParams(map[string]string{"episodeId": strconv.Itoa(int(episode.ID))}),
+		)
+		if err != nil {
+			logging.Log.Error("Failed to fetch episode releases", "id", strconv.Itoa(int(episode.ID)), "err", err)
+			return FetchReleasesResult{Error: err}
+		}
+		return FetchReleasesResult{Releases: releases}
+	}
+}
+
+// FetchSeasonReleases searches all indexers for releases of a season of the selected serie
+func (c *Client) FetchSeasonReleases(seasonNumber int32) tea.Cmd {
+	return func() tea.Msg {
+		if c.serie == nil {
+			logging.Log.Error(ErrNoSerieSelected)
+			return FetchReleasesResult{Error: ErrNoSerieSelected}
+		}
+		releases, err := c.sonarr.GetReleases(context.Background(),
+			httpclient.WithParams(map[string]string{
+				"seriesId":     strconv.Itoa(int(c.serie.ID)),
+				"seasonNumber": strconv.Itoa(int(seasonNumber)),
+			}),
+		)
+		if err != nil {
+			logging.Log.Error("Failed to fetch season releases", "series", c.serie.Title, "season", seasonNumber, "err", err)
+			return FetchReleasesResult{Error: err}
+		}
+		return FetchReleasesResult{Releases: releases}
+	}
+}
+
+// GrabRelease sends a release to the download client
+func (c *Client) GrabRelease(release *sonarr.ReleaseResource) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := c.sonarr.PostRelease(context.Background(), release); err != nil {
+			logging.Log.Error("Failed to grab release", "title", release.Title, "err", err)
+			return GrabReleaseResult{Title: release.Title, Error: err}
+		}
+		return GrabReleaseResult{Title: release.Title}
+	}
+}
@@ -0,0 +1,72 @@
+package sonarr
+
+import (
+	"context"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type FetchRenamePreviewResult struct {
+	Files []*sonarr.RenameEpisodeResource
+	Error error
+}
+
+// FetchRenamePreview fetches the files of the selected serie which would be renamed.
+// If season is nil, the files of all seasons are fetched.
+func (c *Client) FetchRenamePreview(season *int32) tea.Cmd {
+	return func() tea.Msg {
+		if c.serie == nil {
+			logging.Log.Error(ErrNoSerieSelected)
+			return FetchRenamePreviewResult{Error: ErrNoSerieSelected}
+		}
+		params := map[string]string{
+			"seriesId": strconv.Itoa(int(c.serie.ID)),
+		}
+		if season != nil {
+			params["seasonNumber"] = strconv.Itoa(int(*season))
+		}
+		files, err := c.sonarr.GetRenamePreview(context.Background(), httpclient.WithParams(params))
+		if err != nil {
+			logging.Log.Error("Failed to fetch rename preview", "series", c.serie.Title, "err", err)
+			return FetchRenamePreviewResult{Error: err}
+		}
+		return FetchRenamePreviewResult{Files: files}
+	}
+}
+
+// RenameFiles renames the given episode files of the selected serie
+func (c *Client) RenameFiles(fileIDs ...int32) tea.Cmd {
+	return func() tea.Msg {
+		if c.serie == nil {
+			logging.Log.Error(ErrNoSerieSelected)
+			return ErrNoSerieSelected
+		}
+
+		req := sonarr.CommandRequest{
+			Name:     "RenameFiles",
+			SeriesID: c.serie.ID,
+			Files:    fileIDs,
+		}
+		return c.doCommandRequest(&req)
+	}
+}
+
+// RenameSeries renames all files of the selected serie
+func (c *Client) RenameSeries() tea.Cmd {
+	return func() tea.Msg {
+		if c.serie == nil {
+			logging.Log.Error(ErrNoSerieSelected)
+			return ErrNoSerieSelected
+		}
+
+		req := sonarr.CommandRequest{
+			Name:      "RenameSeries",
+			SeriesIDs: []int32{c.serie.ID},
+		}
+		return c.doCommandRequest(&req)
+	}
+}
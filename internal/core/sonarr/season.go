@@ -76,6 +76,27 @@ func (c *Client) ToggleMonitorSeries() tea.Cmd {
 	}
 }
 
+type SetEpisodesMonitoredResult struct {
+	EpisodeIDs []int32
+	Monitored  bool
+	Error      error
+}
+
+// SetEpisodesMonitored sets the monitored state of the given episodes in a single request
+func (c *Client) SetEpisodesMonitored(episodeIDs []int32, monitored bool) tea.Cmd {
+	return func() tea.Msg {
+		params := &sonarr.EpisodesMonitoredResource{
+			EpisodeIDs: episodeIDs,
+			Monitored:  monitored,
+		}
+		if err := c.sonarr.SetEpisodesMonitored(context.Background(), params); err != nil {
+			logging.Log.Error("Failed to set episodes monitored state", "ids", episodeIDs, "monitored", monitored, "err", err)
+			return SetEpisodesMonitoredResult{EpisodeIDs: episodeIDs, Monitored: monitored, Error: fmt.Errorf("Failed to set episodes monitored state")} //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+		return SetEpisodesMonitoredResult{EpisodeIDs: episodeIDs, Monitored: monitored}
+	}
+}
+
 type FetchSeasonEpisodesResult struct {
 	Episodes []*sonarr.EpisodeResource
 	Error    error
@@ -0,0 +1,184 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+// SeasonPassMonitorTypes are the monitor types which can be applied to existing series
+var SeasonPassMonitorTypes = []sonarr.MonitorType{
+	sonarr.All,
+	sonarr.Future,
+	sonarr.Missing,
+	sonarr.Existing,
+	sonarr.Pilot,
+	sonarr.FirstSeason,
+	sonarr.LatestSeason,
+	sonarr.None,
+}
+
+// latestSeasonCutoff is the time after which the latest season is no longer monitored once it finished airing
+const latestSeasonCutoff = 90 * 24 * time.Hour
+
+// SeasonChange is a season whose monitored state changes
+type SeasonChange struct {
+	SeasonNumber int32
+	Monitored    bool
+}
+
+// EpisodeChange is an episode whose monitored state changes
+type EpisodeChange struct {
+	Episode   *sonarr.EpisodeResource
+	Monitored bool
+}
+
+// SeasonPassPreview contains the changes a monitor type would apply to a series
+type SeasonPassPreview struct {
+	Series *sonarr.SeriesResource
+	// UnmonitorSeries is true if the series itself gets unmonitored
+	UnmonitorSeries bool
+	Seasons         []SeasonChange
+	Episodes        []EpisodeChange
+}
+
+// Changed reports whether the monitor type changes anything
+func (p SeasonPassPreview) Changed() bool {
+	return p.UnmonitorSeries || len(p.Seasons) > 0 || len(p.Episodes) > 0
+}
+
+type SeasonPassPreviewResult struct {
+	Monitor  sonarr.MonitorType
+	Previews []*SeasonPassPreview
+	Error    error
+}
+
+type SeasonPassResult struct {
+	Count   int
+	Monitor sonarr.MonitorType
+	Error   error
+}
+
+// FetchSeasonPassPreview fetches the episodes of the series and calculates which seasons and episodes the monitor type would change
+func (c *Client) FetchSeasonPassPreview(series []*sonarr.SeriesResource, monitor sonarr.MonitorType) tea.Cmd {
+	return func() tea.Msg {
+		previews := make([]*SeasonPassPreview, 0, len(series))
+		for _, serie := range series {
+			episodes, err := c.sonarr.GetAllEpisodes(context.Background(), serie.ID)
+			if err != nil {
+				logging.Log.Error("Failed to fetch episodes", "series", serie.Title, "err", err)
+				return SeasonPassPreviewResult{Monitor: monitor, Error: fmt.Errorf("Failed to fetch episodes of %s", serie.Title)} //lint:ignore ST1005 Error will be displayed in the status bar
+			}
+			previews = append(previews, previewSeasonPass(serie, episodes, monitor, time.Now()))
+		}
+		return SeasonPassPreviewResult{Monitor: monitor, Previews: previews}
+	}
+}
+
+// SeasonPass applies the monitor type to the episodes and seasons of all given series
+func (c *Client) SeasonPass(seriesIDs []int32, monitor sonarr.MonitorType) tea.Cmd {
+	return func() tea.Msg {
+		params := &sonarr.SeasonPassResource{
+			Series:            make([]*sonarr.SeasonPassSeriesResource, len(seriesIDs)),
+			MonitoringOptions: &sonarr.MonitoringOptions{Monitor: monitor},
+		}
+		for i, id := range seriesIDs {
+			params.Series[i] = &sonarr.SeasonPassSeriesResource{ID: id}
+		}
+		if err := c.sonarr.PostSeasonPass(context.Background(), params); err != nil {
+			logging.Log.Error("Failed to apply season pass", "ids", seriesIDs, "monitor", monitor, "err", err)
+			return SeasonPassResult{Count: len(seriesIDs), Monitor: monitor, Error: fmt.Errorf("Failed to change monitoring of %d series", len(seriesIDs))} //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+		return SeasonPassResult{Count: len(seriesIDs), Monitor: monitor}
+	}
+}
+
+// previewSeasonPass mirrors the rules sonarr uses to apply a monitor type to the episodes and seasons of a series
+func previewSeasonPass(serie *sonarr.SeriesResource, episodes []*sonarr.EpisodeResource, monitor sonarr.MonitorType, now time.Time) *SeasonPassPreview {
+	var firstSeason, lastSeason int32
+	for _, season := range serie.Seasons {
+		if season.SeasonNumber > 0 && (firstSeason == 0 || season.SeasonNumber < firstSeason) {
+			firstSeason = season.SeasonNumber
+		}
+		if season.SeasonNumber > lastSeason {
+			lastSeason = season.SeasonNumber
+		}
+	}
+
+	aired := func(e *sonarr.EpisodeResource) bool {
+		return !e.AirDateUTC.IsZero() && e.AirDateUTC.Before(now)
+	}
+
+	var monitored func(e *sonarr.EpisodeResource) bool
+	switch monitor {
+	case sonarr.All:
+		monitored = func(e *sonarr.EpisodeResource) bool { return true }
+	case sonarr.Future:
+		monitored = func(e *sonarr.EpisodeResource) bool { return !aired(e) }
+	case sonarr.Missing:
+		monitored = func(e *sonarr.EpisodeResource) bool { return !e.HasFile }
+	case sonarr.Existing:
+		monitored = func(e *sonarr.EpisodeResource) bool { return e.HasFile || !aired(e) }
+	case sonarr.Pilot:
+		monitored = func(e *sonarr.EpisodeResource) bool { return e.SeasonNumber == firstSeason && e.EpisodeNumber == 1 }
+	case sonarr.FirstSeason:
+		monitored = func(e *sonarr.EpisodeResource) bool { return e.SeasonNumber == firstSeason }
+	case sonarr.LastSeason:
+		monitored = func(e *sonarr.EpisodeResource) bool { return e.SeasonNumber == lastSeason }
+	case sonarr.LatestSeason:
+		// the latest season isn't monitored anymore if it finished airing a while ago
+		finished := true
+		for _, e := range episodes {
+			if e.SeasonNumber == lastSeason && (e.AirDateUTC.IsZero() || e.AirDateUTC.After(now.Add(-latestSeasonCutoff))) {
+				finished = false
+				break
+			}
+		}
+		monitored = func(e *sonarr.EpisodeResource) bool { return !finished && e.SeasonNumber == lastSeason }
+	default:
+		monitored = func(e *sonarr.EpisodeResource) bool { return false }
+	}
+
+	preview := &SeasonPassPreview{
+		Series:          serie,
+		UnmonitorSeries: monitor == sonarr.None && serie.Monitored,
+	}
+
+	monitoredSeasons := make(map[int32]bool)
+	for _, e := range episodes {
+		// specials are never monitored by a monitor type
+		state := e.SeasonNumber > 0 && monitored(e)
+		if state {
+			monitoredSeasons[e.SeasonNumber] = true
+		}
+		if state != e.Monitored {
+			preview.Episodes = append(preview.Episodes, EpisodeChange{Episode: e, Monitored: state})
+		}
+	}
+	sort.SliceStable(preview.Episodes, func(i, j int) bool {
+		a, b := preview.Episodes[i].Episode, preview.Episodes[j].Episode
+		if a.SeasonNumber != b.SeasonNumber {
+			return a.SeasonNumber < b.SeasonNumber
+		}
+		return a.EpisodeNumber < b.EpisodeNumber
+	})
+
+	for _, season := range serie.Seasons {
+		// the latest season stays monitored for new episodes
+		state := monitoredSeasons[season.SeasonNumber] ||
+			(season.SeasonNumber > 0 && season.SeasonNumber == lastSeason && (monitor == sonarr.All || monitor == sonarr.Future))
+		if state != season.Monitored {
+			preview.Seasons = append(preview.Seasons, SeasonChange{SeasonNumber: season.SeasonNumber, Monitored: state})
+		}
+	}
+	sort.SliceStable(preview.Seasons, func(i, j int) bool {
+		return preview.Seasons[i].SeasonNumber < preview.Seasons[j].SeasonNumber
+	})
+
+	return preview
+}
@@ -0,0 +1,69 @@
+package sonarr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewSeasonPass(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	serie := &sonarr.SeriesResource{
+		ID:        1,
+		Monitored: true,
+		Seasons: []*sonarr.SeasonResource{
+			{SeasonNumber: 0},
+			{SeasonNumber: 1, Monitored: true},
+			{SeasonNumber: 2, Monitored: true},
+		},
+	}
+	newEpisodes := func() []*sonarr.EpisodeResource {
+		return []*sonarr.EpisodeResource{
+			{ID: 1, SeasonNumber: 0, EpisodeNumber: 1, AirDateUTC: now.AddDate(-2, 0, 0)},
+			{ID: 2, SeasonNumber: 1, EpisodeNumber: 1, AirDateUTC: now.AddDate(-1, 0, 0), HasFile: true, Monitored: true},
+			{ID: 3, SeasonNumber: 1, EpisodeNumber: 2, AirDateUTC: now.AddDate(-1, 0, 0), Monitored: true},
+			{ID: 4, SeasonNumber: 2, EpisodeNumber: 1, AirDateUTC: now.AddDate(0, 0, -7), Monitored: true},
+			{ID: 5, SeasonNumber: 2, EpisodeNumber: 2, AirDateUTC: now.AddDate(0, 0, 7), Monitored: true},
+		}
+	}
+
+	changes := func(p *SeasonPassPreview) map[int32]bool {
+		res := make(map[int32]bool)
+		for _, c := range p.Episodes {
+			res[c.Episode.ID] = c.Monitored
+		}
+		return res
+	}
+
+	p := previewSeasonPass(serie, newEpisodes(), sonarr.All, now)
+	assert.False(t, p.Changed())
+
+	p = previewSeasonPass(serie, newEpisodes(), sonarr.Future, now)
+	assert.Equal(t, map[int32]bool{2: false, 3: false, 4: false}, changes(p))
+	assert.Equal(t, []SeasonChange{{SeasonNumber: 1, Monitored: false}}, p.Seasons)
+
+	p = previewSeasonPass(serie, newEpisodes(), sonarr.Existing, now)
+	assert.Equal(t, map[int32]bool{3: false, 4: false}, changes(p))
+	assert.Empty(t, p.Seasons)
+
+	p = previewSeasonPass(serie, newEpisodes(), sonarr.Pilot, now)
+	assert.Equal(t, map[int32]bool{3: false, 4: false, 5: false}, changes(p))
+	assert.Equal(t, []SeasonChange{{SeasonNumber: 2, Monitored: false}}, p.Seasons)
+
+	p = previewSeasonPass(serie, newEpisodes(), sonarr.LatestSeason, now)
+	assert.Equal(t, map[int32]bool{2: false, 3: false}, changes(p))
+	assert.Equal(t, []SeasonChange{{SeasonNumber: 1, Monitored: false}}, p.Seasons)
+
+	// the latest season finished airing more than 90 days ago
+	p = previewSeasonPass(serie, newEpisodes(), sonarr.LatestSeason, now.AddDate(1, 0, 0))
+	assert.Len(t, p.Episodes, 4)
+	assert.Len(t, p.Seasons, 2)
+	assert.False(t, p.UnmonitorSeries)
+
+	p = previewSeasonPass(serie, newEpisodes(), sonarr.None, now)
+	assert.True(t, p.UnmonitorSeries)
+	assert.Len(t, p.Episodes, 4)
+	assert.Equal(t, []SeasonChange{{SeasonNumber: 1, Monitored: false}, {SeasonNumber: 2, Monitored: false}}, p.Seasons)
+}
@@ -2,8 +2,8 @@ package sonarr
 
 import (
 	"context"
+	"fmt"
 	"regexp"
-	"sort"
 	"strconv"
 	"strings"
 	"unicode"
@@ -20,6 +20,11 @@ type FetchSeriesResult struct {
 	Error error
 }
 
+// ReloadSeriesResult is the result of a background reload of the series
+type ReloadSeriesResult struct {
+	Error error
+}
+
 type AddSeriesResult struct {
 	AddedTitle string
 	Items      []list.Item
@@ -32,6 +37,24 @@ type DeleteSeriesResult struct {
 	Error        error
 }
 
+type EditSeriesResult struct {
+	Serie *sonarr.SeriesResource
+	Items []list.Item
+	Error error
+}
+
+type BulkEditSeriesResult struct {
+	Count int
+	Items []list.Item
+	Error error
+}
+
+type BulkDeleteSeriesResult struct {
+	Count int
+	Items []list.Item
+	Error error
+}
+
 type SeriesItem struct {
 	Series *sonarr.SeriesResource
 }
@@ -46,10 +69,25 @@ func (c *Client) FetchSeries() tea.Cmd {
 			logging.Log.Error("Failed to fetch series", "err", err)
 			return FetchSeriesResult{Error: err}
 		}
+		// the queue is only needed to filter the series, don't fail if it's not available
+		_ = c.fetchQueuedSeries()
 		return FetchSeriesResult{Items: c.newSeriesItems()}
 	}
 }
 
+// ReloadSeries fetches the series like FetchSeries.
+// It's meant for reloads in the background, which mustn't reset the view showing the series.
+func (c *Client) ReloadSeries() tea.Cmd {
+	return func() tea.Msg {
+		if err := c.fetchSeries(); err != nil {
+			logging.Log.Error("Failed to reload series", "err", err)
+			return ReloadSeriesResult{Error: err}
+		}
+		_ = c.fetchQueuedSeries()
+		return ReloadSeriesResult{}
+	}
+}
+
 func (c *Client) fetchSeries() error {
 	series, err := c.sonarr.GetSeries(context.Background())
 	if err != nil {
@@ -85,9 +123,7 @@ func (c *Client) newSeriesItems() []list.Item {
 
 // sortSeries sorts the series by their sort title
 func sortSeries(series []*sonarr.SeriesResource) {
-	sort.Slice(series, func(i, j int) bool {
-		return series[i].SortTitle < series[j].SortTitle
-	})
+	SortSeries(series, SortByTitle, false)
 }
 
 func sanitizeSeriesResources(series []*sonarr.SeriesResource) {
@@ -136,6 +172,110 @@ func (c *Client) PostSeries(series *sonarr.SeriesResource) tea.Cmd {
 	}
 }
 
+// EditSeries saves the changes of a series.
+// If moveFiles is set and the path changed, sonarr moves the files to the new location.
+func (c *Client) EditSeries(series *sonarr.SeriesResource, moveFiles bool) tea.Cmd {
+	return func() tea.Msg {
+		var opts []httpclient.RequestOpts
+		if moveFiles {
+			opts = append(opts, httpclient.WithParams(map[string]string{"moveFiles": "true"}))
+		}
+		serie, err := c.sonarr.PutSerie(context.Background(), series, opts...)
+		if err != nil {
+			logging.Log.Error("Failed to edit series", "err", err)
+			return EditSeriesResult{Error: fmt.Errorf("Failed to save %s", series.Title)} //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+
+		if qp := c.GetQualityProfileByID(serie.QualityProfileID); qp != nil {
+			serie.ProfileName = qp.Name
+		}
+		sanitizeSeriesResources([]*sonarr.SeriesResource{serie})
+
+		for i, s := range c.series {
+			if s.ID == serie.ID {
+				c.series[i] = serie
+				break
+			}
+		}
+		if c.serie != nil && c.serie.ID == serie.ID {
+			c.serie = serie
+		}
+
+		return EditSeriesResult{
+			Serie: serie,
+			Items: c.newSeriesItems(),
+		}
+	}
+}
+
+// BulkEditSeries applies the changes of the editor to all series of the editor
+func (c *Client) BulkEditSeries(editor *sonarr.SeriesEditorResource) tea.Cmd {
+	return func() tea.Msg {
+		series, err := c.sonarr.PutSeriesEditor(context.Background(), editor)
+		if err != nil {
+			logging.Log.Error("Failed to edit series", "err", err)
+			return BulkEditSeriesResult{Error: fmt.Errorf("Failed to save %d series", len(editor.SeriesIDs))} //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+
+		sanitizeSeriesResources(series)
+		for _, serie := range series {
+			if qp := c.GetQualityProfileByID(serie.QualityProfileID); qp != nil {
+				serie.ProfileName = qp.Name
+			}
+			for i, s := range c.series {
+				if s.ID != serie.ID {
+					continue
+				}
+				// the editor doesn't return the statistics
+				if serie.Statistics == nil {
+					serie.Statistics = s.Statistics
+				}
+				c.series[i] = serie
+				break
+			}
+		}
+		sortSeries(c.series)
+
+		return BulkEditSeriesResult{
+			Count: len(editor.SeriesIDs),
+			Items: c.newSeriesItems(),
+		}
+	}
+}
+
+// BulkDeleteSeries deletes all given series
+func (c *Client) BulkDeleteSeries(series []*sonarr.SeriesResource, deleteFiles, addExclusion bool) tea.Cmd {
+	return func() tea.Msg {
+		editor := sonarr.SeriesEditorResource{
+			DeleteFiles:            deleteFiles,
+			AddImportListExclusion: addExclusion,
+		}
+		deleted := make(map[int32]bool, len(series))
+		for _, s := range series {
+			editor.SeriesIDs = append(editor.SeriesIDs, s.ID)
+			deleted[s.ID] = true
+		}
+
+		if err := c.sonarr.DeleteSeriesEditor(context.Background(), &editor); err != nil {
+			logging.Log.Error("Failed to delete series", "err", err)
+			return BulkDeleteSeriesResult{Error: fmt.Errorf("Failed to delete %d series", len(series))} //lint:ignore ST1005 Error will be displayed in the status bar
+		}
+
+		remaining := c.series[:0]
+		for _, s := range c.series {
+			if !deleted[s.ID] {
+				remaining = append(remaining, s)
+			}
+		}
+		c.series = remaining
+
+		return BulkDeleteSeriesResult{
+			Count: len(series),
+			Items: c.newSeriesItems(),
+		}
+	}
+}
+
 func (c *Client) DeleteSeries(series *sonarr.SeriesResource, deleteFiles, addExclusion bool) tea.Cmd {
 	return func() tea.Msg {
 		if err := c.sonarr.DeleteSerie(context.Background(), series.ID, httpclient.WithParams(
@@ -3,6 +3,7 @@ package sonarr
 import (
 	"context"
 	"strings"
+	"sync"
 
 	"github.com/jon4hz/submarr/internal/config"
 	"github.com/jon4hz/submarr/internal/logging"
@@ -34,6 +35,17 @@ type Client struct {
 	rootFolders []*sonarr.RootFolderResource
 	// all available languageProfiles
 	languageProfiles []*sonarr.LanguageProfileResource
+	// all available tags
+	tags []*sonarr.TagResource
+	// health issues reported by sonarr
+	health []*sonarr.HealthResource
+	// disk space of all mounts
+	diskSpace []*sonarr.DiskSpaceResource
+	// ids of the series with downloads in the queue
+	queuedSeries map[int32]bool
+	// connection to the signalr hub, nil if live updates aren't running
+	live   *liveUpdates
+	liveMu sync.Mutex
 }
 
 func New(cfg *config.SonarrConfig, sonarr *sonarr.Client) *Client {
@@ -66,6 +78,7 @@ func (c *Client) Init() error {
 		c.FetchQualityProfiles,
 		c.FetchRootFolders,
 		c.FetchLanguageProfiles,
+		c.FetchTags,
 	}
 	for _, collector := range collectors {
 		if err := collector(); err != nil {
@@ -73,6 +86,10 @@ func (c *Client) Init() error {
 		}
 	}
 
+	// the health and the disk space are only shown on the tile, the client is usable without them
+	_ = c.FetchHealth()
+	_ = c.FetchDiskSpace()
+
 	return nil
 }
 
@@ -162,6 +179,51 @@ func (c *Client) FetchLanguageProfiles() error {
 	return nil
 }
 
+// FetchTags fetches all tags
+func (c *Client) FetchTags() error {
+	tags, err := c.sonarr.GetTags(context.Background())
+	if err != nil {
+		logging.Log.Error("Failed to fetch tags", "err", err)
+		return err
+	}
+
+	c.tags = tags
+
+	return nil
+}
+
+// FetchHealth fetches the health issues
+func (c *Client) FetchHealth() error {
+	health, err := c.sonarr.GetHealth(context.Background())
+	if err != nil {
+		logging.Log.Error("Failed to fetch health", "err", err)
+		c.health = nil
+		return err
+	}
+
+	if health == nil {
+		// an empty slice means there are no issues, nil means the health is unknown
+		health = make([]*sonarr.HealthResource, 0)
+	}
+	c.health = health
+
+	return nil
+}
+
+// FetchDiskSpace fetches the disk space of all mounts
+func (c *Client) FetchDiskSpace() error {
+	disks, err := c.sonarr.GetDiskSpace(context.Background())
+	if err != nil {
+		logging.Log.Error("Failed to fetch disk space", "err", err)
+		c.diskSpace = nil
+		return err
+	}
+
+	c.diskSpace = disks
+
+	return nil
+}
+
 func (c *Client) ClientListItem() ClientItem {
 	return ClientItem{c}
 }
@@ -174,6 +236,11 @@ func (c *Client) GetSerie() *sonarr.SeriesResource {
 	return c.serie
 }
 
+// GetSeries returns all series fetched by the last call of FetchSeries
+func (c *Client) GetSeries() []*sonarr.SeriesResource {
+	return c.series
+}
+
 func (c *Client) GetQualityProfiles() []*sonarr.QualityProfileResource {
 	return c.qualityProfiles
 }
@@ -186,6 +253,10 @@ func (c *Client) GetLanguageProfiles() []*sonarr.LanguageProfileResource {
 	return c.languageProfiles
 }
 
+func (c *Client) GetTags() []*sonarr.TagResource {
+	return c.tags
+}
+
 // GetQualityProfileByID returns a quality profile by id or an empty quality profile if not found
 func (c *Client) GetLanguageProfileByID(id int32) *sonarr.LanguageProfileResource {
 	if c.languageProfiles == nil {
@@ -0,0 +1,265 @@
+package sonarr
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+// SeriesSortKey is the attribute the series overview is sorted by
+type SeriesSortKey string
+
+const (
+	SortByTitle          SeriesSortKey = "title"
+	SortByNextAiring     SeriesSortKey = "nextAiring"
+	SortByPreviousAiring SeriesSortKey = "previousAiring"
+	SortByAdded          SeriesSortKey = "added"
+	SortBySizeOnDisk     SeriesSortKey = "sizeOnDisk"
+	SortByProgress       SeriesSortKey = "progress"
+	SortByNetwork        SeriesSortKey = "network"
+	SortByQualityProfile SeriesSortKey = "qualityProfile"
+)
+
+// SeriesSortKeys are all sort keys in the order they are cycled through
+var SeriesSortKeys = []SeriesSortKey{
+	SortByTitle,
+	SortByNextAiring,
+	SortByPreviousAiring,
+	SortByAdded,
+	SortBySizeOnDisk,
+	SortByProgress,
+	SortByNetwork,
+	SortByQualityProfile,
+}
+
+func (k SeriesSortKey) String() string {
+	switch k {
+	case SortByNextAiring:
+		return "Next Airing"
+	case SortByPreviousAiring:
+		return "Previous Airing"
+	case SortByAdded:
+		return "Added"
+	case SortBySizeOnDisk:
+		return "Size"
+	case SortByProgress:
+		return "Progress"
+	case SortByNetwork:
+		return "Network"
+	case SortByQualityProfile:
+		return "Quality Profile"
+	default:
+		return "Title"
+	}
+}
+
+// ParseSeriesSortKey returns the sort key with the given name, unknown names fall back to the title
+func ParseSeriesSortKey(name string) SeriesSortKey {
+	for _, k := range SeriesSortKeys {
+		if string(k) == name {
+			return k
+		}
+	}
+	return SortByTitle
+}
+
+// SeriesFilter is a predefined filter of the series overview
+type SeriesFilter string
+
+const (
+	FilterAll         SeriesFilter = ""
+	FilterMonitored   SeriesFilter = "monitored"
+	FilterUnmonitored SeriesFilter = "unmonitored"
+	FilterContinuing  SeriesFilter = "continuing"
+	FilterEnded       SeriesFilter = "ended"
+	FilterMissing     SeriesFilter = "missing"
+	FilterQueued      SeriesFilter = "queued"
+)
+
+// SeriesFilters are all predefined filters in the order they are cycled through
+var SeriesFilters = []SeriesFilter{
+	FilterAll,
+	FilterMonitored,
+	FilterUnmonitored,
+	FilterContinuing,
+	FilterEnded,
+	FilterMissing,
+	FilterQueued,
+}
+
+func (f SeriesFilter) String() string {
+	switch f {
+	case FilterMonitored:
+		return "Monitored"
+	case FilterUnmonitored:
+		return "Unmonitored"
+	case FilterContinuing:
+		return "Continuing"
+	case FilterEnded:
+		return "Ended"
+	case FilterMissing:
+		return "Missing Episodes"
+	case FilterQueued:
+		return "Queued"
+	default:
+		return "All"
+	}
+}
+
+// ParseSeriesFilter returns the filter with the given name, unknown names fall back to all series
+func ParseSeriesFilter(name string) SeriesFilter {
+	for _, f := range SeriesFilters {
+		if string(f) == name {
+			return f
+		}
+	}
+	return FilterAll
+}
+
+// Matches returns true if the series passes the filter
+func (c *Client) Matches(f SeriesFilter, series *sonarr.SeriesResource) bool {
+	switch f {
+	case FilterMonitored:
+		return series.Monitored
+	case FilterUnmonitored:
+		return !series.Monitored
+	case FilterContinuing:
+		return series.Status != sonarr.Ended
+	case FilterEnded:
+		return series.Status == sonarr.Ended
+	case FilterMissing:
+		return series.Statistics != nil && series.Statistics.EpisodeFileCount < series.Statistics.EpisodeCount
+	case FilterQueued:
+		return c.queuedSeries[series.ID]
+	default:
+		return true
+	}
+}
+
+// SortSeries sorts the series by the given key, ties are sorted by title.
+// Series without a value for the key, e.g. ended series without a next airing, are always sorted last,
+// no matter if the order is reversed.
+func SortSeries(series []*sonarr.SeriesResource, key SeriesSortKey, reverse bool) {
+	sort.SliceStable(series, func(i, j int) bool {
+		a, b := series[i], series[j]
+		if hasA, hasB := hasSortValue(a, key), hasSortValue(b, key); hasA != hasB {
+			return hasA
+		}
+		cmp := compareSeries(a, b, key)
+		if cmp == 0 {
+			return a.SortTitle < b.SortTitle
+		}
+		if reverse {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+}
+
+// hasSortValue returns false if the series has no value for the key
+func hasSortValue(series *sonarr.SeriesResource, key SeriesSortKey) bool {
+	switch key {
+	case SortByNextAiring:
+		return !series.NextAiring.IsZero()
+	case SortByPreviousAiring:
+		return !series.PreviousAiring.IsZero()
+	case SortByAdded:
+		return !series.Added.IsZero()
+	default:
+		return true
+	}
+}
+
+// compareSeries returns a negative number if a comes before b
+func compareSeries(a, b *sonarr.SeriesResource, key SeriesSortKey) int {
+	switch key {
+	case SortByNextAiring:
+		return compareTimes(a.NextAiring, b.NextAiring)
+	case SortByPreviousAiring:
+		// the most recent airing comes first
+		return -compareTimes(a.PreviousAiring, b.PreviousAiring)
+	case SortByAdded:
+		// the most recently added series comes first
+		return -compareTimes(a.Added, b.Added)
+	case SortBySizeOnDisk:
+		// the biggest series comes first
+		return -compareInts(sizeOnDisk(a), sizeOnDisk(b))
+	case SortByProgress:
+		return compareFloats(progress(a), progress(b))
+	case SortByNetwork:
+		return strings.Compare(strings.ToLower(a.Network), strings.ToLower(b.Network))
+	case SortByQualityProfile:
+		return strings.Compare(strings.ToLower(a.ProfileName), strings.ToLower(b.ProfileName))
+	default:
+		return strings.Compare(a.SortTitle, b.SortTitle)
+	}
+}
+
+// compareTimes returns a negative number if a is before b
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Equal(b):
+		return 0
+	case a.Before(b):
+		return -1
+	default:
+		return 1
+	}
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func sizeOnDisk(s *sonarr.SeriesResource) int64 {
+	if s.Statistics == nil {
+		return 0
+	}
+	return s.Statistics.SizeOnDisk
+}
+
+func progress(s *sonarr.SeriesResource) float64 {
+	if s.Statistics == nil {
+		return 0
+	}
+	return s.Statistics.PercentOfEpisodes
+}
+
+// fetchQueuedSeries fetches the ids of the series with downloads in the queue
+func (c *Client) fetchQueuedSeries() error {
+	queue, err := c.sonarr.GetQueueDetails(context.Background(), 0)
+	if err != nil {
+		logging.Log.Error("Failed to fetch queue details", "err", err)
+		return err
+	}
+
+	queued := make(map[int32]bool)
+	for _, item := range queue {
+		queued[item.SeriesID] = true
+	}
+	c.queuedSeries = queued
+
+	return nil
+}
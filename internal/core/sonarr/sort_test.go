@@ -0,0 +1,45 @@
+package sonarr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func sortedTitles(series []*sonarr.SeriesResource) []string {
+	titles := make([]string, len(series))
+	for i, s := range series {
+		titles[i] = s.SortTitle
+	}
+	return titles
+}
+
+func TestSortSeries(t *testing.T) {
+	now := time.Now()
+	newSeries := func() []*sonarr.SeriesResource {
+		return []*sonarr.SeriesResource{
+			{SortTitle: "ended"},
+			{SortTitle: "later", NextAiring: now.Add(48 * time.Hour), Added: now.Add(-time.Hour)},
+			{SortTitle: "soon", NextAiring: now.Add(time.Hour), Added: now.Add(-48 * time.Hour)},
+		}
+	}
+
+	series := newSeries()
+	SortSeries(series, SortByNextAiring, false)
+	assert.Equal(t, []string{"soon", "later", "ended"}, sortedTitles(series))
+
+	// series without a value stay last in reverse order
+	series = newSeries()
+	SortSeries(series, SortByNextAiring, true)
+	assert.Equal(t, []string{"later", "soon", "ended"}, sortedTitles(series))
+
+	series = newSeries()
+	SortSeries(series, SortByAdded, false)
+	assert.Equal(t, []string{"later", "soon", "ended"}, sortedTitles(series))
+
+	series = newSeries()
+	SortSeries(series, SortByAdded, true)
+	assert.Equal(t, []string{"soon", "later", "ended"}, sortedTitles(series))
+}
@@ -0,0 +1,58 @@
+package sonarr
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type FetchSystemResult struct {
+	Status    *sonarr.SystemResource
+	Health    []*sonarr.HealthResource
+	DiskSpace []*sonarr.DiskSpaceResource
+	Tasks     []*sonarr.TaskResource
+	Error     error
+}
+
+// FetchSystem fetches the status, the health issues, the disk space and the scheduled tasks
+func (c *Client) FetchSystem() tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		status, err := c.sonarr.GetSystemStatus(ctx)
+		if err != nil {
+			logging.Log.Error("Failed to fetch system status", "err", err)
+			return FetchSystemResult{Error: err}
+		}
+		health, err := c.sonarr.GetHealth(ctx)
+		if err != nil {
+			logging.Log.Error("Failed to fetch health", "err", err)
+			return FetchSystemResult{Error: err}
+		}
+		disks, err := c.sonarr.GetDiskSpace(ctx)
+		if err != nil {
+			logging.Log.Error("Failed to fetch disk space", "err", err)
+			return FetchSystemResult{Error: err}
+		}
+		sort.SliceStable(disks, func(i, j int) bool {
+			return disks[i].Path < disks[j].Path
+		})
+		tasks, err := c.sonarr.GetTasks(ctx)
+		if err != nil {
+			logging.Log.Error("Failed to fetch tasks", "err", err)
+			return FetchSystemResult{Error: err}
+		}
+		sort.SliceStable(tasks, func(i, j int) bool {
+			return strings.ToLower(tasks[i].Name) < strings.ToLower(tasks[j].Name)
+		})
+		return FetchSystemResult{
+			Status:    status,
+			Health:    health,
+			DiskSpace: disks,
+			Tasks:     tasks,
+		}
+	}
+}
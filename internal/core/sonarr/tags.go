@@ -0,0 +1,169 @@
+package sonarr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type FetchTagDetailsResult struct {
+	Tags  []*sonarr.TagDetailsResource
+	Error error
+}
+
+type SaveTagResult struct {
+	Tag *sonarr.TagResource
+	// Created is false if an existing tag was renamed
+	Created bool
+	Error   error
+}
+
+type DeleteTagResult struct {
+	Label string
+	Error error
+}
+
+// FetchTagDetails fetches all tags including the resources using them, sorted by their label
+func (c *Client) FetchTagDetails() tea.Cmd {
+	return func() tea.Msg {
+		tags, err := c.sonarr.GetTagDetails(context.Background())
+		if err != nil {
+			logging.Log.Error("Failed to fetch tag details", "err", err)
+			return FetchTagDetailsResult{Error: err}
+		}
+		sort.Slice(tags, func(i, j int) bool {
+			return strings.ToLower(tags[i].Label) < strings.ToLower(tags[j].Label)
+		})
+		return FetchTagDetailsResult{Tags: tags}
+	}
+}
+
+// CreateTag creates a new tag with the given label
+func (c *Client) CreateTag(label string) tea.Cmd {
+	return func() tea.Msg {
+		if err := c.validateTagLabel(label, 0); err != nil {
+			return SaveTagResult{Created: true, Error: err}
+		}
+		tag, err := c.sonarr.PostTag(context.Background(), label)
+		if err != nil {
+			logging.Log.Error("Failed to create tag", "err", err)
+			//lint:ignore ST1005 Error will be displayed in the status bar
+			return SaveTagResult{Created: true, Error: fmt.Errorf("Failed to create tag %s", label)}
+		}
+		c.tags = append(c.tags, tag)
+		return SaveTagResult{Tag: tag, Created: true}
+	}
+}
+
+// RenameTag changes the label of an existing tag
+func (c *Client) RenameTag(tagID int32, label string) tea.Cmd {
+	return func() tea.Msg {
+		if err := c.validateTagLabel(label, tagID); err != nil {
+			return SaveTagResult{Error: err}
+		}
+		tag, err := c.sonarr.PutTag(context.Background(), &sonarr.TagResource{ID: tagID, Label: label})
+		if err != nil {
+			logging.Log.Error("Failed to rename tag", "err", err)
+			//lint:ignore ST1005 Error will be displayed in the status bar
+			return SaveTagResult{Error: fmt.Errorf("Failed to rename tag to %s", label)}
+		}
+		for i, t := range c.tags {
+			if t.ID == tag.ID {
+				c.tags[i] = tag
+			}
+		}
+		return SaveTagResult{Tag: tag}
+	}
+}
+
+// DeleteTag deletes a tag, sonarr removes it from all resources using it
+func (c *Client) DeleteTag(tag *sonarr.TagResource) tea.Cmd {
+	return func() tea.Msg {
+		if err := c.sonarr.DeleteTag(context.Background(), tag.ID); err != nil {
+			logging.Log.Error("Failed to delete tag", "err", err)
+			//lint:ignore ST1005 Error will be displayed in the status bar
+			return DeleteTagResult{Label: tag.Label, Error: fmt.Errorf("Failed to delete tag %s", tag.Label)}
+		}
+		tags := make([]*sonarr.TagResource, 0, len(c.tags))
+		for _, t := range c.tags {
+			if t.ID != tag.ID {
+				tags = append(tags, t)
+			}
+		}
+		c.tags = tags
+		// the tag is gone from the series as well
+		for _, s := range c.series {
+			s.Tags = removeTag(s.Tags, tag.ID)
+		}
+		return DeleteTagResult{Label: tag.Label}
+	}
+}
+
+// validateTagLabel checks that the label is valid and not used by another tag.
+// Sonarr stores labels in lowercase and doesn't allow whitespace.
+func (c *Client) validateTagLabel(label string, tagID int32) error {
+	if label == "" {
+		//lint:ignore ST1005 Error will be displayed in the status bar
+		return errors.New("The label must not be empty")
+	}
+	if strings.ContainsAny(label, " \t") {
+		//lint:ignore ST1005 Error will be displayed in the status bar
+		return errors.New("The label must not contain spaces")
+	}
+	for _, t := range c.tags {
+		if t.ID != tagID && strings.EqualFold(t.Label, label) {
+			//lint:ignore ST1005 Error will be displayed in the status bar
+			return fmt.Errorf("The tag %s already exists", t.Label)
+		}
+	}
+	return nil
+}
+
+// GetTagByID returns a tag by id or nil if not found
+func (c *Client) GetTagByID(id int32) *sonarr.TagResource {
+	for _, t := range c.tags {
+		if t.ID == id {
+			return t
+		}
+	}
+	return nil
+}
+
+// TagLabels returns the labels of the given tag ids, unknown tags are skipped
+func (c *Client) TagLabels(ids []int32) []string {
+	labels := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if t := c.GetTagByID(id); t != nil {
+			labels = append(labels, t.Label)
+		}
+	}
+	return labels
+}
+
+// HasAnyTag returns true if the series has at least one of the given tags
+func HasAnyTag(series *sonarr.SeriesResource, tagIDs []int32) bool {
+	for _, id := range tagIDs {
+		for _, t := range series.Tags {
+			if t == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func removeTag(tags []int32, id int32) []int32 {
+	res := make([]int32, 0, len(tags))
+	for _, t := range tags {
+		if t != id {
+			res = append(res, t)
+		}
+	}
+	return res
+}
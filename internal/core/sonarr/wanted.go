@@ -0,0 +1,63 @@
+package sonarr
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	"github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+// WantedPageSize is the number of wanted episodes fetched per page
+const WantedPageSize = 20
+
+// Wanted is the kind of the wanted episodes
+type Wanted int
+
+const (
+	WantedMissing Wanted = iota + 1
+	WantedCutoffUnmet
+)
+
+type FetchWantedResult struct {
+	Wanted   Wanted
+	Episodes *sonarr.EpisodeResourcePagingResource
+	Error    error
+}
+
+// FetchWanted fetches a page of the missing or cutoff unmet episodes, most recently aired first
+func (c *Client) FetchWanted(wanted Wanted, page int) tea.Cmd {
+	return func() tea.Msg {
+		opts := []httpclient.RequestOpts{
+			httpclient.WithPage(page),
+			httpclient.WithPageSize(WantedPageSize),
+			httpclient.WithSortKey("airDateUtc"),
+			httpclient.WithSortDirection(httpclient.Descending),
+			httpclient.WithParams(map[string]string{
+				"includeSeries":      "true",
+				"includeEpisodeFile": "true",
+				"monitored":          "true",
+			}),
+		}
+
+		var (
+			episodes *sonarr.EpisodeResourcePagingResource
+			err      error
+		)
+		switch wanted {
+		case WantedMissing:
+			episodes, err = c.sonarr.GetMissings(context.Background(), opts...)
+		case WantedCutoffUnmet:
+			episodes, err = c.sonarr.GetCutoffUnmet(context.Background(), opts...)
+		}
+		if err != nil {
+			logging.Log.Error("Failed to fetch wanted episodes", "wanted", wanted, "err", err)
+			return FetchWantedResult{Wanted: wanted, Error: err}
+		}
+		if wanted == WantedMissing {
+			c.totalMissing = episodes.TotalRecords
+		}
+		return FetchWantedResult{Wanted: wanted, Episodes: episodes}
+	}
+}
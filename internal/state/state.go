@@ -0,0 +1,90 @@
+// Package state persists the choices a user makes in the tui between runs,
+// e.g. the sorting of the series overview.
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// State represents the persisted state
+type State struct {
+	SonarrOverview OverviewState `json:"sonarrOverview"`
+}
+
+// OverviewState is the sorting and filtering of an overview
+type OverviewState struct {
+	SortKey     string `json:"sortKey"`
+	SortReverse bool   `json:"sortReverse"`
+	Filter      string `json:"filter"`
+}
+
+// mu serializes the access to the state file, it's written by tea commands
+var mu sync.Mutex
+
+// Load reads the state from the user config dir.
+// If no state was saved yet, an empty state is returned.
+func Load() (*State, error) {
+	path, err := getStateFile()
+	if err != nil {
+		return &State{}, err
+	}
+	return load(path)
+}
+
+// Save writes the state to the user config dir
+func (s *State) Save() error {
+	path, err := getStateFile()
+	if err != nil {
+		return err
+	}
+	return s.save(path)
+}
+
+func load(path string) (*State, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var s State
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &s, nil
+		}
+		return &s, err
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return &State{}, err
+	}
+	return &s, nil
+}
+
+func (s *State) save(path string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	// write to a temporary file first, so an interrupted write doesn't corrupt the state
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func getStateFile() (string, error) {
+	folder, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(folder, "submarr", "state.json"), nil
+}
@@ -0,0 +1,46 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSave(t *testing.T) {
+	tmpDir, err := os.MkdirTemp(os.TempDir(), "submarr-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	path := filepath.Join(tmpDir, "submarr", "state.json")
+
+	// a missing file results in an empty state
+	s, err := load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &State{}, s)
+
+	s.SonarrOverview = OverviewState{
+		SortKey:     "sizeOnDisk",
+		SortReverse: true,
+		Filter:      "missing",
+	}
+	assert.NoError(t, s.save(path))
+	assert.NoFileExists(t, path+".tmp")
+
+	loaded, err := load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, s, loaded)
+
+	// an invalid file results in an empty state and an error
+	assert.NoError(t, os.WriteFile(path, []byte("{invalid"), 0o644))
+	loaded, err = load(path)
+	assert.Error(t, err)
+	assert.Equal(t, &State{}, loaded)
+}
+
+func TestGetStateFile(t *testing.T) {
+	path, err := getStateFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "state.json", filepath.Base(path))
+}
@@ -0,0 +1,52 @@
+package common
+
+// Pager keeps track of the current page of a paged resource
+type Pager struct {
+	Page     int
+	PageSize int
+	// Total is the number of records on all pages
+	Total int
+}
+
+// NewPager returns a pager on the first page
+func NewPager(pageSize int) Pager {
+	return Pager{Page: 1, PageSize: pageSize}
+}
+
+// TotalPages returns the number of pages, there is always at least one
+func (p Pager) TotalPages() int {
+	if p.Total <= 0 || p.PageSize <= 0 {
+		return 1
+	}
+	return (p.Total + p.PageSize - 1) / p.PageSize
+}
+
+// NextPage moves to the next page and returns false if there is none
+func (p *Pager) NextPage() bool {
+	if p.Page >= p.TotalPages() {
+		return false
+	}
+	p.Page++
+	return true
+}
+
+// PrevPage moves to the previous page and returns false if there is none
+func (p *Pager) PrevPage() bool {
+	if p.Page <= 1 {
+		return false
+	}
+	p.Page--
+	return true
+}
+
+// SetTotal sets the number of records on all pages.
+// If the current page doesn't exist anymore, e.g. after records were removed,
+// the pager moves to the last page and returns true, so the page can be fetched again.
+func (p *Pager) SetTotal(total int) bool {
+	p.Total = total
+	if p.Page > p.TotalPages() {
+		p.Page = p.TotalPages()
+		return true
+	}
+	return false
+}
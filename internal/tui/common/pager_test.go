@@ -0,0 +1,27 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPager(t *testing.T) {
+	p := NewPager(20)
+	assert.Equal(t, 1, p.TotalPages())
+	assert.False(t, p.NextPage())
+	assert.False(t, p.PrevPage())
+
+	assert.False(t, p.SetTotal(41))
+	assert.Equal(t, 3, p.TotalPages())
+	assert.True(t, p.NextPage())
+	assert.True(t, p.NextPage())
+	assert.False(t, p.NextPage())
+	assert.Equal(t, 3, p.Page)
+
+	// the last page is gone, so the pager moves to the new last page
+	assert.True(t, p.SetTotal(40))
+	assert.Equal(t, 2, p.Page)
+	assert.True(t, p.SetTotal(0))
+	assert.Equal(t, 1, p.Page)
+}
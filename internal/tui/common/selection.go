@@ -0,0 +1,60 @@
+package common
+
+// Selection holds the keys of the items which are selected for a bulk action.
+// Only selected keys are stored, so the length of the selection is the number of selected items.
+type Selection[K comparable] map[K]bool
+
+// Set selects or unselects the key
+func (s Selection[K]) Set(key K, selected bool) {
+	if selected {
+		s[key] = true
+		return
+	}
+	delete(s, key)
+}
+
+// Toggle selects the key or unselects it if it's selected already
+func (s Selection[K]) Toggle(key K) {
+	s.Set(key, !s[key])
+}
+
+// ToggleAll selects all keys or unselects them if all of them are selected already
+func (s Selection[K]) ToggleAll(keys []K) {
+	all := true
+	for _, key := range keys {
+		if !s[key] {
+			all = false
+			break
+		}
+	}
+	for _, key := range keys {
+		s.Set(key, !all)
+	}
+}
+
+// Retain unselects all keys which aren't in the given keys
+func (s Selection[K]) Retain(keys []K) {
+	keep := make(map[K]bool, len(keys))
+	for _, key := range keys {
+		keep[key] = true
+	}
+	for key := range s {
+		if !keep[key] {
+			delete(s, key)
+		}
+	}
+}
+
+// Clear unselects all keys
+func (s Selection[K]) Clear() {
+	clear(s)
+}
+
+// Keys returns the selected keys in no particular order
+func (s Selection[K]) Keys() []K {
+	keys := make([]K, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	return keys
+}
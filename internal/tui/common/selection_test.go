@@ -0,0 +1,29 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelection(t *testing.T) {
+	s := make(Selection[int32])
+	s.Toggle(1)
+	s.Toggle(2)
+	s.Toggle(1)
+	assert.Equal(t, Selection[int32]{2: true}, s)
+
+	s.ToggleAll([]int32{2, 3})
+	assert.Len(t, s, 2)
+	s.ToggleAll([]int32{2, 3})
+	assert.Empty(t, s)
+
+	s.Set(1, true)
+	s.Set(2, true)
+	s.Set(3, false)
+	s.Retain([]int32{2, 3})
+	assert.Equal(t, []int32{2}, s.Keys())
+
+	s.Clear()
+	assert.Empty(t, s)
+}
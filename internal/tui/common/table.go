@@ -0,0 +1,79 @@
+package common
+
+import (
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+)
+
+// NewTable returns a table with the default styles of submarr
+func NewTable() table.Model {
+	t := table.New()
+
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(styles.SubtleColor).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(lipgloss.Color("229")).
+		Bold(false)
+	t.SetStyles(s)
+
+	return t
+}
+
+// FitColumns gives every column the width of its widest cell and the remaining width to the flex column
+func FitColumns(width int, rows []table.Row, titles []string, flex int) []table.Column {
+	widths := make([]int, len(titles))
+	for i, title := range titles {
+		widths[i] = lipgloss.Width(title)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], lipgloss.Width(cell))
+		}
+	}
+
+	// every column has a padding of 1 on each side
+	used := len(titles) * 2
+	for i, w := range widths {
+		if i != flex {
+			used += w
+		}
+	}
+	widths[flex] = max(width-used, 4)
+
+	columns := make([]table.Column, len(titles))
+	for i, title := range titles {
+		columns[i] = table.Column{Title: title, Width: widths[i]}
+	}
+	return columns
+}
+
+// FitTable sets the rows of a table which is shown in a box and fits the table into the size of the box.
+// reserved is the number of lines in the box which aren't available for the rows, e.g. the table header.
+func FitTable(t *table.Model, box lipgloss.Style, width, height, reserved int, rows []table.Row, titles []string, flex int) {
+	SetTableRows(t, width-box.GetHorizontalFrameSize(), rows, titles, flex)
+
+	available := height - box.GetVerticalFrameSize() - reserved
+	t.SetHeight(max(min(len(rows), available), 1))
+}
+
+// SetTableRows sets the rows of the table, fits the columns into the width and keeps the cursor on a row
+func SetTableRows(t *table.Model, width int, rows []table.Row, titles []string, flex int) {
+	// columns must be set before the rows, otherwise the table panics
+	t.SetColumns(FitColumns(width, rows, titles, flex))
+	t.SetRows(rows)
+	t.SetWidth(width)
+
+	// the cursor of the table is -1 as long as there are no rows
+	switch {
+	case len(rows) == 0:
+	case t.Cursor() < 0:
+		t.SetCursor(0)
+	case t.Cursor() >= len(rows):
+		t.SetCursor(len(rows) - 1)
+	}
+}
@@ -21,8 +21,18 @@ type ClientsItem interface {
 	Available() bool
 	// Some stats about the client. Will be displayed next to each other separated by a dot
 	Stats() []string
+	// Number of health warnings and errors reported by the client, ok is false if unknown
+	Health() (warnings, errors int, ok bool)
+	// The lowest free disk space of all root folders in percent, negative if unknown
+	LowestFreeSpace() float64
 }
 
+// Thresholds of the free disk space in percent below which the disk space is highlighted
+const (
+	diskSpaceWarning  = 20
+	diskSpaceCritical = 10
+)
+
 var (
 	selectedForeground = lipgloss.AdaptiveColor{Light: "#1a1a1a", Dark: "#dddddd"}
 
@@ -37,6 +47,8 @@ var (
 
 	statsStyle = lipgloss.NewStyle()
 
+	badgeStyle = lipgloss.NewStyle()
+
 	separator = lipgloss.NewStyle().
 			Foreground(styles.SubtleColor).
 			Padding(0, 1).
@@ -73,7 +85,20 @@ func renderItem(item ClientsItem, itemWidth int, isSelected bool) string {
 			statsBuilder.WriteString(separator)
 		}
 	}
-	stats := truncate.StringWithTail(statsBuilder.String(), uint(itemWidth), common.Ellipsis)
+	stats := statsBuilder.String()
+
+	// show the badges on the right side if there is enough space
+	if badges := renderBadges(item); badges != "" {
+		if gap := itemWidth - lipgloss.Width(stats) - lipgloss.Width(badges); gap > 0 {
+			stats += strings.Repeat(" ", gap) + badges
+		} else {
+			stats += separator + badges
+		}
+	}
+	// truncate would also cut off the last character of a line which fits exactly
+	if lipgloss.Width(stats) > itemWidth {
+		stats = truncate.StringWithTail(stats, uint(itemWidth), common.Ellipsis)
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Top,
 		lipgloss.JoinHorizontal(lipgloss.Top,
@@ -82,3 +107,43 @@ func renderItem(item ClientsItem, itemWidth int, isSelected bool) string {
 		stats,
 	)
 }
+
+// renderBadges renders the health and the lowest free disk space of an available client
+func renderBadges(item ClientsItem) string {
+	if !item.Available() {
+		return ""
+	}
+
+	var badges []string
+	if warnings, errors, ok := item.Health(); ok {
+		if errors > 0 {
+			badges = append(badges, badgeStyle.Foreground(styles.ErrorColor).Render(fmt.Sprintf("✖ %d %s", errors, plural(errors, "error"))))
+		}
+		if warnings > 0 {
+			badges = append(badges, badgeStyle.Foreground(styles.WarningColor).Render(fmt.Sprintf("⚠ %d %s", warnings, plural(warnings, "warning"))))
+		}
+		if errors == 0 && warnings == 0 {
+			badges = append(badges, badgeStyle.Foreground(styles.OkColor).Render("✔ healthy"))
+		}
+	}
+
+	if free := item.LowestFreeSpace(); free >= 0 {
+		color := styles.OkColor
+		switch {
+		case free < diskSpaceCritical:
+			color = styles.ErrorColor
+		case free < diskSpaceWarning:
+			color = styles.WarningColor
+		}
+		badges = append(badges, badgeStyle.Foreground(color).Render(fmt.Sprintf("%.0f%% free", free)))
+	}
+
+	return strings.Join(badges, separator)
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+	return word + "s"
+}
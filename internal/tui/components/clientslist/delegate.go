@@ -32,7 +32,7 @@ var (
 
 	selectedRadarr = selectedStyle.Copy().
 			Foreground(lipgloss.AdaptiveColor{Light: "#1a1a1a", Dark: "#dddddd"}).
-			BorderForeground(lipgloss.Color("#FFA500"))
+			BorderForeground(styles.RadarrOrange)
 )
 
 type clientDelegate struct {
@@ -0,0 +1,104 @@
+package confirm
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+)
+
+// Model is a simple yes/no dialog.
+// If the user confirms, the model goes back and returns the command passed to New.
+type Model struct {
+	common.EmbedableModel
+
+	title     string
+	question  string
+	onConfirm tea.Cmd
+	color     lipgloss.TerminalColor
+
+	yes bool
+}
+
+func New(title, question string, onConfirm tea.Cmd, color lipgloss.TerminalColor, width, height int) *Model {
+	m := Model{
+		title:     title,
+		question:  question,
+		onConfirm: onConfirm,
+		color:     color,
+	}
+
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Init() tea.Cmd {
+	return statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+}
+
+func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, DefaultKeyMap.Switch):
+			m.yes = !m.yes
+
+		case key.Matches(msg, DefaultKeyMap.Select):
+			m.IsBack = true
+			if m.yes {
+				return m, m.onConfirm
+			}
+
+		case key.Matches(msg, DefaultKeyMap.Yes):
+			m.IsBack = true
+			return m, m.onConfirm
+
+		case key.Matches(msg, DefaultKeyMap.No), key.Matches(msg, DefaultKeyMap.Back):
+			m.IsBack = true
+
+		case key.Matches(msg, DefaultKeyMap.Quit):
+			m.IsQuit = true
+		}
+	}
+	return m, nil
+}
+
+var (
+	boxStyle    = lipgloss.NewStyle().Border(lipgloss.RoundedBorder(), true).Padding(1, 2, 1, 2)
+	titleStyle  = lipgloss.NewStyle().Align(lipgloss.Center).Bold(true).Underline(true)
+	buttonStyle = lipgloss.NewStyle().Align(lipgloss.Center).Border(lipgloss.RoundedBorder(), true).Padding(0, 1, 0).Margin(0, 1)
+)
+
+func (m Model) View() string {
+	var s strings.Builder
+
+	width := min(lipgloss.Width(m.question), m.Width)
+	width = max(width, lipgloss.Width(m.title))
+
+	s.WriteString(titleStyle.Width(width).Render(m.title))
+	s.WriteString("\n\n")
+	s.WriteString(lipgloss.NewStyle().Width(width).Render(m.question))
+	s.WriteString("\n\n")
+
+	yesColor, noColor := lipgloss.TerminalColor(styles.SubtleColor), m.color
+	if m.yes {
+		yesColor, noColor = m.color, styles.SubtleColor
+	}
+	buttons := lipgloss.JoinHorizontal(lipgloss.Top,
+		buttonStyle.BorderForeground(noColor).Render("No"),
+		buttonStyle.BorderForeground(yesColor).Render("Yes"),
+	)
+	s.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, buttons))
+
+	return boxStyle.Render(s.String())
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = max(width-boxStyle.GetHorizontalFrameSize(), 0)
+	m.Height = max(height-boxStyle.GetVerticalFrameSize(), 0)
+}
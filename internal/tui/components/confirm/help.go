@@ -0,0 +1,31 @@
+package confirm
+
+import "github.com/charmbracelet/bubbles/key"
+
+type KeyMap struct {
+	Quit   key.Binding
+	Back   key.Binding
+	Help   key.Binding
+	Select key.Binding
+	Switch key.Binding
+	Yes    key.Binding
+	No     key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	Quit:   key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+	Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:   key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+	Select: key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("enter", "select")),
+	Switch: key.NewBinding(key.WithKeys("left", "right", "h", "l", "tab"), key.WithHelp("←/→", "switch")),
+	Yes:    key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "yes")),
+	No:     key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "no")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Switch, k.Select},
+		{k.Yes, k.No},
+		{k.Help, k.Back, k.Quit},
+	}
+}
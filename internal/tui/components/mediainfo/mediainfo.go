@@ -10,6 +10,7 @@ import (
 	"github.com/jon4hz/submarr/internal/tui/common"
 	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
 	"github.com/jon4hz/submarr/internal/tui/styles"
+	radarrAPI "github.com/jon4hz/submarr/pkg/radarr"
 	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
 )
 
@@ -18,17 +19,78 @@ type kv struct {
 	value string
 }
 
+// MediaInfo holds the media information of a file, independent of the client it came from
+type MediaInfo struct {
+	AudioBitrate     int64
+	AudioChannels    float64
+	AudioCodec       string
+	AudioLanguages   string
+	AudioStreamCount int32
+	VideoBitDepth    int32
+	VideoBitrate     int64
+	VideoCodec       string
+	VideoFps         float64
+	Resolution       string
+	RunTime          string
+	ScanType         string
+	Subtitles        string
+}
+
+// FromSonarr converts the media info of a sonarr episode file
+func FromSonarr(info *sonarrAPI.MediaInfoResource) *MediaInfo {
+	if info == nil {
+		return new(MediaInfo)
+	}
+	return &MediaInfo{
+		AudioBitrate:     info.AudioBitrate,
+		AudioChannels:    info.AudioChannels,
+		AudioCodec:       info.AudioCodec,
+		AudioLanguages:   info.AudioLanguages,
+		AudioStreamCount: info.AudioStreamCount,
+		VideoBitDepth:    info.VideoBitDepth,
+		VideoBitrate:     info.VideoBitrate,
+		VideoCodec:       info.VideoCodec,
+		VideoFps:         info.VideoFps,
+		Resolution:       info.Resolution,
+		RunTime:          info.RunTime,
+		ScanType:         info.ScanType,
+		Subtitles:        info.Subtitles,
+	}
+}
+
+// FromRadarr converts the media info of a radarr movie file
+func FromRadarr(info *radarrAPI.MediaInfoResource) *MediaInfo {
+	if info == nil {
+		return new(MediaInfo)
+	}
+	return &MediaInfo{
+		AudioBitrate:     info.AudioBitrate,
+		AudioChannels:    info.AudioChannels,
+		AudioCodec:       info.AudioCodec,
+		AudioLanguages:   info.AudioLanguages,
+		AudioStreamCount: info.AudioStreamCount,
+		VideoBitDepth:    info.VideoBitDepth,
+		VideoBitrate:     info.VideoBitrate,
+		VideoCodec:       info.VideoCodec,
+		VideoFps:         info.VideoFps,
+		Resolution:       info.Resolution,
+		RunTime:          info.RunTime,
+		ScanType:         info.ScanType,
+		Subtitles:        info.Subtitles,
+	}
+}
+
 type Model struct {
 	common.EmbedableModel
 
-	mediaInfo  *sonarrAPI.MediaInfoResource
+	mediaInfo  *MediaInfo
 	kvs        [13]kv
 	longestKey int
 }
 
-func New(episode *sonarrAPI.EpisodeResource, width, height int) common.SubModel {
+func New(mediaInfo *MediaInfo, width, height int) common.SubModel {
 	m := Model{
-		mediaInfo: episode.EpisodeFile.MediaInfo,
+		mediaInfo: mediaInfo,
 	}
 
 	m.setKVs()
@@ -0,0 +1,69 @@
+package addmovie
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	radarrAPI "github.com/jon4hz/submarr/pkg/radarr"
+)
+
+type minimumAvailabilityItem struct {
+	minimumAvailability radarrAPI.MovieStatusType
+	triggered           bool
+}
+
+var minimumAvailabilities = [...]radarrAPI.MovieStatusType{
+	radarrAPI.Announced,
+	radarrAPI.InCinemas,
+	radarrAPI.Released,
+}
+
+func newMinimumAvailabilityItems(selected radarrAPI.MovieStatusType) []list.Item {
+	listItems := make([]list.Item, len(minimumAvailabilities))
+	for i, item := range minimumAvailabilities {
+		listItems[i] = minimumAvailabilityItem{
+			minimumAvailability: item,
+			triggered:           item == selected,
+		}
+	}
+	return listItems
+}
+
+func (d minimumAvailabilityItem) FilterValue() string { return "" }
+
+type minimumAvailabilityDelegate struct{}
+
+func (d minimumAvailabilityDelegate) Height() int { return 1 }
+
+func (d minimumAvailabilityDelegate) Spacing() int { return 0 }
+
+func (d minimumAvailabilityDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(minimumAvailabilityItem)
+	if !ok {
+		return
+	}
+
+	var text string
+	if i.triggered {
+		text = fmt.Sprintf("✅ %s", i.minimumAvailability)
+	} else {
+		text = fmt.Sprintf("⬜ %s", i.minimumAvailability)
+	}
+
+	var (
+		isSelected = index == m.Index()
+		title      string
+	)
+
+	if isSelected {
+		title = itemStyles.SelectedTitle.Render(text)
+	} else {
+		title = itemStyles.NormalTitle.Render(text)
+	}
+
+	fmt.Fprintf(w, "%s", title)
+}
+
+func (d minimumAvailabilityDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
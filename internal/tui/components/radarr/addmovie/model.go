@@ -0,0 +1,423 @@
+package addmovie
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/radarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	radarr_list "github.com/jon4hz/submarr/internal/tui/components/radarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/components/toggle"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	radarrAPI "github.com/jon4hz/submarr/pkg/radarr"
+)
+
+type Model struct {
+	common.EmbedableModel
+
+	client *radarr.Client
+	movie  *radarrAPI.MovieResource
+
+	rootFolder          list.Model
+	minimumAvailability list.Model
+	qualityProfile      list.Model
+	monitor             toggle.Model
+	searchForMovie      toggle.Model
+
+	selectedOption     addOption
+	showOptions        bool
+	longestOptionWidth int
+}
+
+type addOption int
+
+const (
+	addOptionRootFolder addOption = iota + 1
+	addOptionMonitor
+	addOptionMinimumAvailability
+	addOptionQualityProfile
+	addOptionSearchForMovie
+	addOptionAddMovie
+)
+
+var addOptions = map[addOption]string{
+	addOptionRootFolder:          "Root Folder",
+	addOptionMonitor:             "Monitor",
+	addOptionMinimumAvailability: "Minimum Availability",
+	addOptionQualityProfile:      "Quality Profile",
+	addOptionSearchForMovie:      "Search on add",
+	addOptionAddMovie:            "",
+}
+
+func New(client *radarr.Client, movie *radarrAPI.MovieResource, width, height int) common.SubModel {
+	setDefaults(client, movie)
+
+	m := Model{
+		client:             client,
+		movie:              movie,
+		selectedOption:     1,
+		longestOptionWidth: getLongestOptionWidth(),
+		rootFolder: radarr_list.New(
+			"Select Root Folder",
+			newRootFolderItems(client.GetRootFolders(), movie.RootFolderPath),
+			rootFolderDelegate{},
+			width, height,
+		),
+		minimumAvailability: radarr_list.New(
+			"Select Minimum Availability",
+			newMinimumAvailabilityItems(movie.MinimumAvailability),
+			minimumAvailabilityDelegate{},
+			width, height,
+		),
+		qualityProfile: radarr_list.New(
+			"Select Quality Profile",
+			newQualityProfileItems(client.GetQualityProfiles(), movie.QualityProfileID),
+			qualityProfileDelegate{},
+			width, height,
+		),
+		monitor:        toggle.New(true),
+		searchForMovie: toggle.New(true),
+	}
+
+	// make sure id is 0
+	m.movie.ID = 0
+
+	m.SetSize(width, height)
+
+	for _, l := range []list.Model{
+		m.rootFolder,
+		m.minimumAvailability,
+		m.qualityProfile,
+	} {
+		l.SetShowFilter(false)
+		l.SetShowStatusBar(false)
+	}
+
+	return &m
+}
+
+func getLongestOptionWidth() int {
+	var longest int
+	for _, option := range addOptions {
+		if len(option) > longest {
+			longest = len(option)
+		}
+	}
+	return longest
+}
+
+func setDefaults(client *radarr.Client, movie *radarrAPI.MovieResource) {
+	rootFolders := client.GetRootFolders()
+	if client.Config.DefaultRootFolder != "" {
+		for _, rootFolder := range rootFolders {
+			if rootFolder.Path == client.Config.DefaultRootFolder {
+				movie.RootFolderPath = rootFolder.Path
+				break
+			}
+		}
+	}
+	if movie.RootFolderPath == "" && len(rootFolders) > 0 {
+		movie.RootFolderPath = rootFolders[0].Path
+	}
+
+	qualityProfiles := client.GetQualityProfiles()
+	movie.QualityProfileID = 0
+	if client.Config.DefaultQualityProfile != "" {
+		for _, qualityProfile := range qualityProfiles {
+			if qualityProfile.Name == client.Config.DefaultQualityProfile {
+				movie.QualityProfileID = qualityProfile.ID
+				break
+			}
+		}
+	}
+	if movie.QualityProfileID == 0 && len(qualityProfiles) > 0 {
+		movie.QualityProfileID = qualityProfiles[0].ID
+	}
+
+	movie.MinimumAvailability = radarrAPI.Released
+	for _, minimumAvailability := range minimumAvailabilities {
+		if string(minimumAvailability) == client.Config.DefaultMinimumAvailability {
+			movie.MinimumAvailability = minimumAvailability
+			break
+		}
+	}
+
+	movie.AddOptions = &radarrAPI.AddMovieOptions{
+		Monitor:        radarrAPI.MovieOnly,
+		SearchForMovie: true,
+		AddMethod:      radarrAPI.AddMethodManual,
+	}
+	movie.Monitored = true
+}
+
+func (m Model) Init() tea.Cmd {
+	return statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+}
+
+func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	if m.showOptions {
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			switch msg.String() {
+			case "esc":
+				m.showOptions = false
+				return m, nil
+			}
+		}
+
+		switch m.selectedOption {
+		case addOptionRootFolder:
+			switch msg := msg.(type) {
+			case tea.KeyMsg:
+				switch msg.String() {
+				case " ":
+					for i, item := range m.rootFolder.Items() {
+						rfItem := item.(rootFolderItem)
+						if i == m.rootFolder.Index() {
+							rfItem.triggered = true
+							m.movie.RootFolderPath = rfItem.rootFolder.Path
+						} else {
+							rfItem.triggered = false
+						}
+						m.rootFolder.SetItem(i, rfItem)
+					}
+				}
+			}
+
+			var cmd tea.Cmd
+			m.rootFolder, cmd = m.rootFolder.Update(msg)
+			return m, cmd
+
+		case addOptionMinimumAvailability:
+			switch msg := msg.(type) {
+			case tea.KeyMsg:
+				switch msg.String() {
+				case " ":
+					for i, item := range m.minimumAvailability.Items() {
+						maItem := item.(minimumAvailabilityItem)
+						if i == m.minimumAvailability.Index() {
+							maItem.triggered = true
+							m.movie.MinimumAvailability = maItem.minimumAvailability
+						} else {
+							maItem.triggered = false
+						}
+						m.minimumAvailability.SetItem(i, maItem)
+					}
+				}
+			}
+
+			var cmd tea.Cmd
+			m.minimumAvailability, cmd = m.minimumAvailability.Update(msg)
+			return m, cmd
+
+		case addOptionQualityProfile:
+			switch msg := msg.(type) {
+			case tea.KeyMsg:
+				switch msg.String() {
+				case " ":
+					for i, item := range m.qualityProfile.Items() {
+						qpItem := item.(qualityProfileItem)
+						if i == m.qualityProfile.Index() {
+							qpItem.triggered = true
+							m.movie.QualityProfileID = qpItem.qualityProfile.ID
+						} else {
+							qpItem.triggered = false
+						}
+						m.qualityProfile.SetItem(i, qpItem)
+					}
+				}
+			}
+
+			var cmd tea.Cmd
+			m.qualityProfile, cmd = m.qualityProfile.Update(msg)
+			return m, cmd
+		}
+
+		return m, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, DefaultKeyMap.Down):
+			m.nextOption()
+		case key.Matches(msg, DefaultKeyMap.Up):
+			m.previousOption()
+		case key.Matches(msg, DefaultKeyMap.Select):
+			switch m.selectedOption {
+			case
+				addOptionMonitor,
+				addOptionSearchForMovie:
+				// no-op
+			case addOptionAddMovie:
+				return m, m.addMovie()
+
+			default:
+				m.showOptions = true
+			}
+		case key.Matches(msg, DefaultKeyMap.Add):
+			return m, m.addMovie()
+		case key.Matches(msg, DefaultKeyMap.Back):
+			m.IsBack = true
+		case key.Matches(msg, DefaultKeyMap.Quit):
+			m.IsQuit = true
+		}
+	}
+
+	switch m.selectedOption {
+	case addOptionMonitor:
+		var cmd tea.Cmd
+		m.monitor, cmd = m.monitor.Update(msg)
+		if m.monitor.Toggled() {
+			m.movie.Monitored = true
+			m.movie.AddOptions.Monitor = radarrAPI.MovieOnly
+		} else {
+			m.movie.Monitored = false
+			m.movie.AddOptions.Monitor = radarrAPI.None
+		}
+		return m, cmd
+
+	case addOptionSearchForMovie:
+		var cmd tea.Cmd
+		m.searchForMovie, cmd = m.searchForMovie.Update(msg)
+		if m.searchForMovie.Toggled() {
+			m.movie.AddOptions.SearchForMovie = true
+		} else {
+			m.movie.AddOptions.SearchForMovie = false
+		}
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m *Model) nextOption() {
+	m.selectedOption++
+	if int(m.selectedOption) > len(addOptions) {
+		m.selectedOption = 1
+	}
+}
+
+func (m *Model) previousOption() {
+	m.selectedOption--
+	if m.selectedOption < 1 {
+		m.selectedOption = addOption(len(addOptions))
+	}
+}
+
+func (m Model) addMovie() tea.Cmd {
+	return m.client.PostMovie(m.movie)
+}
+
+func (m Model) View() string {
+	if !m.showOptions {
+		return m.optionsView()
+	}
+
+	switch m.selectedOption {
+	case addOptionRootFolder:
+		return boxStyle.Width(m.Width - 2).Render(m.rootFolder.View())
+	case addOptionMinimumAvailability:
+		return boxStyle.Width(m.Width - 2).Render(m.minimumAvailability.View())
+	case addOptionQualityProfile:
+		return boxStyle.Width(m.Width - 2).Render(m.qualityProfile.View())
+	default:
+		return m.optionsView()
+	}
+}
+
+var (
+	boxStyle    = lipgloss.NewStyle().Border(lipgloss.RoundedBorder(), true).Padding(1, 2, 1, 2)
+	titleStyle  = lipgloss.NewStyle().Align(lipgloss.Center).Bold(true).Underline(true)
+	keyStyle    = lipgloss.NewStyle().Align(lipgloss.Right).Margin(1, 2, 1, 0)
+	valueStyle  = lipgloss.NewStyle().Border(lipgloss.RoundedBorder(), true).Padding(0, 1, 0)
+	buttonStyle = lipgloss.NewStyle().Align(lipgloss.Center).Border(lipgloss.RoundedBorder(), true).Padding(0, 1, 0)
+)
+
+func (m Model) optionsView() string {
+	var s strings.Builder
+
+	var qualityProfile string
+	if qp := m.client.GetQualityProfileByID(m.movie.QualityProfileID); qp != nil {
+		qualityProfile = qp.Name
+	}
+
+	kvs := [][]string{
+		{
+			addOptions[addOptionRootFolder],
+			m.movie.RootFolderPath,
+		},
+		{
+			addOptions[addOptionMonitor],
+			m.monitor.View(),
+		},
+		{
+			addOptions[addOptionMinimumAvailability],
+			string(m.movie.MinimumAvailability),
+		},
+		{
+			addOptions[addOptionQualityProfile],
+			qualityProfile,
+		},
+		{
+			addOptions[addOptionSearchForMovie],
+			m.searchForMovie.View(),
+		},
+	}
+
+	lines := make([]string, len(kvs))
+	for i, kv := range kvs {
+		var color lipgloss.TerminalColor = styles.SubtleColor
+		if i == int(m.selectedOption)-1 {
+			color = styles.RadarrOrange
+		}
+		lines[i] = lipgloss.JoinHorizontal(lipgloss.Left,
+			keyStyle.Width(m.longestOptionWidth).Render(kv[0]),
+			valueStyle.Width(m.longestOptionWidth).BorderForeground(color).Render(kv[1]),
+		)
+	}
+
+	options := lipgloss.JoinVertical(lipgloss.Right,
+		lines...,
+	)
+
+	width := lipgloss.Width(options)
+	s.WriteString(
+		titleStyle.Width(width).Render(fmt.Sprintf("%s (%d)", m.movie.Title, m.movie.Year)),
+	)
+	s.WriteByte('\n')
+	s.WriteByte('\n')
+
+	s.WriteString(options)
+
+	s.WriteByte('\n')
+	s.WriteByte('\n')
+
+	var color lipgloss.TerminalColor = styles.SubtleColor
+	if m.selectedOption == addOptionAddMovie {
+		color = styles.RadarrOrange
+	}
+	s.WriteString(
+		lipgloss.Place(width, 1, lipgloss.Center,
+			lipgloss.Top, buttonStyle.BorderForeground(color).Render("Add Movie")),
+	)
+
+	return boxStyle.MaxWidth(m.Width).Render(s.String())
+}
+
+func (m *Model) SetSize(width, height int) {
+	width -= boxStyle.GetHorizontalFrameSize()
+	height -= boxStyle.GetVerticalFrameSize()
+
+	m.Width = width
+	m.Height = height
+
+	m.rootFolder.SetSize(width, height)
+	m.minimumAvailability.SetSize(width, height)
+	m.qualityProfile.SetSize(width, height)
+}
@@ -0,0 +1,64 @@
+package addmovie
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	radarrAPI "github.com/jon4hz/submarr/pkg/radarr"
+)
+
+type qualityProfileItem struct {
+	qualityProfile *radarrAPI.QualityProfileResource
+	triggered      bool
+}
+
+func newQualityProfileItems(qualityProfiles []*radarrAPI.QualityProfileResource, selected int32) []list.Item {
+	items := make([]list.Item, len(qualityProfiles))
+	for i, qualityProfile := range qualityProfiles {
+		items[i] = qualityProfileItem{
+			qualityProfile: qualityProfile,
+			triggered:      qualityProfile.ID == selected,
+		}
+	}
+
+	return items
+}
+
+func (d qualityProfileItem) FilterValue() string { return "" }
+
+type qualityProfileDelegate struct{}
+
+func (d qualityProfileDelegate) Height() int { return 1 }
+
+func (d qualityProfileDelegate) Spacing() int { return 0 }
+
+func (d qualityProfileDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(qualityProfileItem)
+	if !ok {
+		return
+	}
+
+	var text string
+	if i.triggered {
+		text = fmt.Sprintf("✅ %s", i.qualityProfile.Name)
+	} else {
+		text = fmt.Sprintf("⬜ %s", i.qualityProfile.Name)
+	}
+
+	var (
+		isSelected = index == m.Index()
+		title      string
+	)
+
+	if isSelected {
+		title = itemStyles.SelectedTitle.Render(text)
+	} else {
+		title = itemStyles.NormalTitle.Render(text)
+	}
+
+	fmt.Fprint(w, title)
+}
+
+func (d qualityProfileDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
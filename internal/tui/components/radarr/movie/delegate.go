@@ -0,0 +1,164 @@
+package movie
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/jon4hz/submarr/internal/core/radarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	radarrAPI "github.com/jon4hz/submarr/pkg/radarr"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/muesli/reflow/truncate"
+)
+
+type Delegate struct{}
+
+var (
+	DefaultStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 2).
+			Margin(0, 1)
+
+	SelectedStyle = DefaultStyle.Copy().
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderForeground(styles.RadarrOrange)
+)
+
+func (d Delegate) Height() int { return 6 }
+
+func (d Delegate) Spacing() int { return 0 }
+
+func (d Delegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d Delegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	var movie string
+
+	x, _ := DefaultStyle.GetFrameSize()
+	itemWidth := m.Width() - x
+	width := itemWidth + DefaultStyle.GetHorizontalPadding()
+
+	i, ok := item.(radarr.MovieItem)
+	if ok {
+		movie = renderItem(i, itemWidth, index == m.Index())
+	} else {
+		return
+	}
+
+	if itemWidth-2 <= 0 {
+		// short-circuit
+		return
+	}
+
+	if index == m.Index() {
+		movie = SelectedStyle.Width(width).Render(movie)
+	} else {
+		movie = DefaultStyle.Width(width).Render(movie)
+	}
+
+	fmt.Fprintf(w, "%s", movie)
+}
+
+var (
+	SelectedForeground = lipgloss.AdaptiveColor{Light: "#1a1a1a", Dark: "#dddddd"}
+
+	TitleStyle = lipgloss.NewStyle().
+			Underline(true).
+			Bold(true).
+			MaxHeight(1)
+
+	Separator = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor).
+			Padding(0, 1).
+			Render("•")
+)
+
+func renderItem(item radarr.MovieItem, itemWidth int, isSelected bool) string {
+	textColor := SelectedForeground
+	if !isSelected {
+		textColor = styles.SubtleColor
+	}
+
+	title := TitleStyle.Foreground(textColor).Render(item.Movie.Title)
+	title = zone.Mark(item.Movie.Title,
+		truncate.StringWithTail(title, uint(itemWidth), common.Ellipsis),
+	)
+
+	monitored := "Unmonitored"
+	if item.Movie.Monitored {
+		monitored = "Monitored"
+	}
+	movieStats := lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Foreground(textColor).Render(fmt.Sprint(item.Movie.Year)),
+		Separator,
+		lipgloss.NewStyle().Foreground(textColor).Render(monitored),
+		Separator,
+		lipgloss.NewStyle().Foreground(textColor).Render(MovieStatus(item.Movie.Status)),
+	)
+	movieStats = truncate.StringWithTail(movieStats, uint(itemWidth), common.Ellipsis)
+
+	fileStats := lipgloss.JoinHorizontal(lipgloss.Top,
+		FileStatus(item.Movie, textColor),
+		Separator,
+		lipgloss.NewStyle().Foreground(textColor).Render(humanize.IBytes(uint64(item.Movie.SizeOnDisk))),
+	)
+	fileStats = truncate.StringWithTail(fileStats, uint(itemWidth), common.Ellipsis)
+
+	var runtime string
+	if item.Movie.Runtime > 0 {
+		runtime = fmt.Sprintf("%d min", item.Movie.Runtime)
+	} else {
+		runtime = "-"
+	}
+	profileStats := lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Foreground(textColor).Render(item.Movie.ProfileName),
+		Separator,
+		lipgloss.NewStyle().Foreground(textColor).Render(runtime),
+	)
+	profileStats = truncate.StringWithTail(profileStats, uint(itemWidth), common.Ellipsis)
+
+	s := lipgloss.JoinVertical(lipgloss.Top,
+		title,
+		movieStats,
+		fileStats,
+		profileStats,
+	)
+
+	return s
+}
+
+// MovieStatus returns a human readable movie status
+func MovieStatus(status radarrAPI.MovieStatusType) string {
+	switch status {
+	case radarrAPI.InCinemas:
+		return "In Cinemas"
+	case radarrAPI.TBA:
+		return "TBA"
+	default:
+		return common.Title(string(status))
+	}
+}
+
+// FileStatus renders the quality of the movie file or whether the movie is missing
+func FileStatus(movie *radarrAPI.MovieResource, textColor lipgloss.TerminalColor) string {
+	switch {
+	case movie.HasFile && movie.MovieFile != nil && movie.MovieFile.Quality != nil && movie.MovieFile.Quality.Quality != nil:
+		quality := movie.MovieFile.Quality.Quality.Name
+		if movie.MovieFile.QualityCutoffNotMet {
+			quality += " (cutoff unmet)"
+		}
+		return lipgloss.NewStyle().Foreground(textColor).Render(quality)
+	case movie.HasFile:
+		return lipgloss.NewStyle().Foreground(textColor).Render("Downloaded")
+	case movie.IsAvailable && movie.Monitored:
+		return lipgloss.NewStyle().Foreground(styles.ErrorColor).Render("Missing")
+	case movie.IsAvailable:
+		return lipgloss.NewStyle().Foreground(textColor).Render("Missing (unmonitored)")
+	default:
+		return lipgloss.NewStyle().Foreground(textColor).Render("Not available")
+	}
+}
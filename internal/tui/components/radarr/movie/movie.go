@@ -0,0 +1,432 @@
+package movie
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/jon4hz/submarr/internal/core/radarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/mediainfo"
+	"github.com/jon4hz/submarr/internal/tui/components/radarr/removemovie"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	radarrAPI "github.com/jon4hz/submarr/pkg/radarr"
+)
+
+type state int
+
+const (
+	stateMovie state = iota + 1
+	stateDetails
+	stateDelete
+)
+
+type focus int
+
+const (
+	focusFiles focus = iota
+	focusHistory
+)
+
+type Model struct {
+	common.EmbedableModel
+
+	client       *radarr.Client
+	state        state
+	focus        focus
+	filesTable   table.Model
+	historyTable table.Model
+	mediaInfo    common.SubModel
+	delete       common.SubModel
+}
+
+func New(client *radarr.Client, width, height int) *Model {
+	m := Model{
+		state:        stateMovie,
+		client:       client,
+		filesTable:   common.NewTable(),
+		historyTable: common.NewTable(),
+	}
+
+	m.SetSize(width, height)
+	m.updateFocus()
+
+	return &m
+}
+
+func (m Model) Init() tea.Cmd {
+	return statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+}
+
+func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateMovie:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Tab):
+				if m.focus == focusFiles {
+					m.focus = focusHistory
+				} else {
+					m.focus = focusFiles
+				}
+				m.updateFocus()
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Select):
+				files := m.client.GetMovieFiles()
+				if m.focus != focusFiles || len(files) == 0 {
+					return m, nil
+				}
+				file := files[m.filesTable.Cursor()]
+				m.state = stateDetails
+				m.mediaInfo = mediainfo.New(mediainfo.FromRadarr(file.MediaInfo), m.Width, m.Height)
+				return m, m.mediaInfo.Init()
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				return m, tea.Batch(
+					m.client.ReloadMovie(),
+					statusbar.NewMessageCmd("Reloading movie...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.ToggleMonitor):
+				return m, tea.Batch(
+					m.client.ToggleMonitorMovie(),
+					statusbar.NewMessageCmd("Toggling movie monitor...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.Refresh):
+				return m, tea.Batch(
+					m.client.RefreshMovie(),
+					statusbar.NewMessageCmd("Refreshing movie...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.AutomaticSearch):
+				return m, tea.Batch(
+					m.client.AutomaticSearchMovie(),
+					statusbar.NewMessageCmd("Searching for movie...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.Delete):
+				return m, m.deleteMovie()
+			}
+
+		case stateDetails:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.state = stateMovie
+				return m, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+		}
+
+	case radarr.FetchMovieResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		m.updateTables()
+		return m, nil
+	}
+
+	switch m.state {
+	case stateMovie:
+		var cmd tea.Cmd
+		switch m.focus {
+		case focusFiles:
+			m.filesTable, cmd = m.filesTable.Update(msg)
+		case focusHistory:
+			m.historyTable, cmd = m.historyTable.Update(msg)
+		}
+		return m, cmd
+
+	case stateDetails:
+		var cmd tea.Cmd
+		m.mediaInfo, cmd = m.mediaInfo.Update(msg)
+		return m, cmd
+
+	case stateDelete:
+		var cmd tea.Cmd
+		m.delete, cmd = m.delete.Update(msg)
+
+		if m.delete.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.delete.Back() {
+			m.state = stateMovie
+			return m, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m *Model) deleteMovie() tea.Cmd {
+	m.state = stateDelete
+	m.delete = removemovie.New(m.client, m.client.GetMovie(), m.Width, m.Height)
+	return m.delete.Init()
+}
+
+func (m *Model) updateFocus() {
+	switch m.focus {
+	case focusFiles:
+		m.filesTable.Focus()
+		m.historyTable.Blur()
+	case focusHistory:
+		m.historyTable.Focus()
+		m.filesTable.Blur()
+	}
+}
+
+// updateTables sets the rows and columns of both tables and distributes the available height
+func (m *Model) updateTables() {
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	fileRows := make([]table.Row, 0, len(m.client.GetMovieFiles()))
+	for _, file := range m.client.GetMovieFiles() {
+		fileRows = append(fileRows, table.Row{
+			file.RelativePath,
+			humanize.IBytes(uint64(file.Size)),
+			quality(file.Quality),
+			languages(file.Languages),
+		})
+	}
+
+	historyRows := make([]table.Row, 0, len(m.client.GetMovieHistory()))
+	for _, record := range m.client.GetMovieHistory() {
+		historyRows = append(historyRows, table.Row{
+			record.Date.Local().Format("02.01.2006 15:04"),
+			EventType(record.EventType),
+			record.SourceTitle,
+			quality(record.Quality),
+		})
+	}
+
+	common.SetTableRows(&m.filesTable, width, fileRows, []string{"Path", "Size", "Quality", "Languages"}, 0)
+	common.SetTableRows(&m.historyTable, width, historyRows, []string{"Date", "Event", "Source Title", "Quality"}, 2)
+
+	// the files table is as high as needed, the history table takes the rest
+	available := m.Height - boxStyle.GetVerticalFrameSize() - lipgloss.Height(m.headerView()) - 2
+	filesHeight := min(len(fileRows), 3) + 2
+	m.filesTable.SetHeight(max(filesHeight, 3))
+	m.historyTable.SetHeight(max(available-filesHeight-1, 3))
+}
+
+func quality(q *radarrAPI.QualityModel) string {
+	if q == nil || q.Quality == nil {
+		return "Unknown"
+	}
+	return q.Quality.Name
+}
+
+func languages(languages []radarrAPI.Language) string {
+	names := make([]string, 0, len(languages))
+	for _, l := range languages {
+		names = append(names, l.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// EventType returns a human readable history event type
+func EventType(eventType radarrAPI.MovieHistoryEventType) string {
+	switch eventType {
+	case radarrAPI.MovieHistoryEventTypeGrabbed:
+		return "Grabbed"
+	case radarrAPI.MovieHistoryEventTypeDownloadFolderImported:
+		return "Imported"
+	case radarrAPI.MovieHistoryEventTypeDownloadFailed:
+		return "Failed"
+	case radarrAPI.MovieHistoryEventTypeMovieFileDeleted:
+		return "Deleted"
+	case radarrAPI.MovieHistoryEventTypeMovieFolderImported:
+		return "Folder Imported"
+	case radarrAPI.MovieHistoryEventTypeMovieFileRenamed:
+		return "Renamed"
+	case radarrAPI.MovieHistoryEventTypeDownloadIgnored:
+		return "Ignored"
+	default:
+		return "Unknown"
+	}
+}
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Padding(0, 1).
+			Background(styles.PurpleColor)
+
+	statusStyle = lipgloss.NewStyle().
+			Background(styles.RadarrOrange).
+			Foreground(lipgloss.Color("#1a1a1a")).
+			Padding(0, 1)
+
+	qualityStyle = lipgloss.NewStyle().
+			Background(styles.BlueColor).
+			Padding(0, 1)
+
+	sectionStyle = lipgloss.NewStyle().
+			Bold(true)
+
+	inactiveSectionStyle = sectionStyle.Copy().
+				Foreground(styles.SubtleColor)
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateMovie:
+		return m.movieView()
+	case stateDetails:
+		return m.movieDetailsView()
+	case stateDelete:
+		return m.deleteView()
+	}
+	return ":("
+}
+
+func (m Model) headerView() string {
+	movie := m.client.GetMovie()
+	contentWidth := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	var s strings.Builder
+	s.WriteString(titleStyle.Render(fmt.Sprintf("%s (%d)", movie.Title, movie.Year)))
+	s.WriteString("\n\n")
+
+	runtime := "-"
+	if movie.Runtime > 0 {
+		runtime = fmt.Sprintf("%d min", movie.Runtime)
+	}
+	s.WriteString("Status:          ")
+	s.WriteString(statusStyle.Render(MovieStatus(movie.Status)))
+	s.WriteString(fmt.Sprintf(" %s", runtime))
+	if movie.Certification != "" {
+		s.WriteString(fmt.Sprintf(" • %s", movie.Certification))
+	}
+	s.WriteByte('\n')
+
+	s.WriteString("Monitored:       ")
+	if movie.Monitored {
+		s.WriteString(common.Available)
+	} else {
+		s.WriteString(common.Unavailable)
+	}
+	s.WriteByte('\n')
+
+	s.WriteString("Quality Profile: ")
+	profile := m.client.GetQualityProfileByID(movie.QualityProfileID)
+	if profile != nil && profile.Name != "" {
+		s.WriteString(qualityStyle.Render(profile.Name))
+	} else {
+		s.WriteString("Unknown")
+	}
+	s.WriteByte('\n')
+
+	s.WriteString("Path:            ")
+	s.WriteString(movie.Path)
+	s.WriteByte('\n')
+
+	s.WriteString("Size:            ")
+	s.WriteString(humanize.IBytes(uint64(movie.SizeOnDisk)))
+	s.WriteString("\n\n")
+
+	overview := "No movie overview"
+	if movie.Overview != "" {
+		overview = movie.Overview
+	}
+	s.WriteString(lipgloss.NewStyle().Width(contentWidth).Render(overview))
+
+	return s.String()
+}
+
+func (m Model) movieView() string {
+	filesTitle, historyTitle := sectionStyle, inactiveSectionStyle
+	if m.focus == focusHistory {
+		filesTitle, historyTitle = inactiveSectionStyle, sectionStyle
+	}
+
+	var s strings.Builder
+	s.WriteString(m.headerView())
+	s.WriteString("\n\n")
+
+	s.WriteString(filesTitle.Render("Files"))
+	s.WriteByte('\n')
+	if len(m.client.GetMovieFiles()) > 0 {
+		s.WriteString(m.filesTable.View())
+	} else {
+		s.WriteString(FileStatus(m.client.GetMovie(), styles.SubtleColor))
+	}
+	s.WriteString("\n\n")
+
+	s.WriteString(historyTitle.Render("History"))
+	s.WriteByte('\n')
+	if len(m.client.GetMovieHistory()) > 0 {
+		s.WriteString(m.historyTable.View())
+	} else {
+		s.WriteString(lipgloss.NewStyle().Foreground(styles.SubtleColor).Render("No history"))
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+var overlayStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	Padding(1, 2, 1, 2)
+
+func (m Model) movieDetailsView() string {
+	fg := overlayStyle.Render(m.mediaInfo.View())
+	x := ((m.Width - lipgloss.Width(fg)) / 2)
+	y := ((m.Height - lipgloss.Height(fg)) / 2)
+	// make sure background fills the whole screen
+	bg := m.movieView()
+	return overlay.PlaceOverlay(x, y, fg, bg)
+}
+
+func (m Model) deleteView() string {
+	fg := m.delete.View()
+	x := ((m.Width - lipgloss.Width(fg)) / 2)
+	y := ((m.Height - lipgloss.Height(fg)) / 2)
+	// make sure background fills the whole screen
+	bg := m.movieView()
+	return overlay.PlaceOverlay(x, y, fg, bg)
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTables()
+
+	if m.mediaInfo != nil {
+		m.mediaInfo.SetSize(width, height)
+	}
+
+	if m.state == stateDelete {
+		m.delete.SetSize(width, height)
+	}
+}
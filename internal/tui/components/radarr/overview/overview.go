@@ -0,0 +1,317 @@
+package overview
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/radarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	radarr_list "github.com/jon4hz/submarr/internal/tui/components/radarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/radarr/movie"
+	"github.com/jon4hz/submarr/internal/tui/components/radarr/search"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	radarrAPI "github.com/jon4hz/submarr/pkg/radarr"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+type state int
+
+const (
+	stateUnknown state = iota
+	stateLoading
+	stateMovies
+	stateMovieLoading
+	stateMovieDetails
+	stateSearch
+)
+
+type Model struct {
+	common.EmbedableModel
+
+	client *radarr.Client
+
+	moviesList list.Model
+
+	submodel common.SubModel
+
+	spinner common.Spinner
+
+	state state
+}
+
+func New(c *radarr.Client, width, height int) common.TabModel {
+	m := Model{
+		state:      stateLoading,
+		client:     c,
+		moviesList: radarr_list.New("Overview", nil, movie.Delegate{}, width, height),
+		spinner:    common.NewSpinner(),
+	}
+
+	m.SetSize(width, height)
+
+	m.moviesList.InfiniteScrolling = true
+	m.moviesList.FilterInput.Prompt = "Search: "
+
+	return &m
+}
+
+func (m Model) Title() string {
+	return "Movies"
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewTitleCmd("Radarr", statusbar.WithTitleForeground(styles.RadarrOrange)),
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.client.FetchMovies(),
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.TabModel, tea.Cmd) {
+	var cmds []tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// handle keybindings per state
+		switch m.state {
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+			}
+
+		case stateMovies:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				if !m.moviesList.SettingFilter() && !m.moviesList.IsFiltered() {
+					m.IsBack = true
+				}
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				if !m.moviesList.SettingFilter() {
+					m.IsQuit = true
+				}
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				if !m.moviesList.SettingFilter() {
+					cmds = append(cmds,
+						m.client.FetchMovies(),
+						m.moviesList.StartSpinner(),
+						statusbar.NewMessageCmd("Reloading...", statusbar.WithMessageTimeout(2)),
+					)
+				}
+
+			case key.Matches(msg, DefaultKeyMap.Select):
+				item, _ := m.moviesList.SelectedItem().(radarr.MovieItem)
+				if !m.moviesList.SettingFilter() && item.Movie != nil {
+					return m, m.loadMovie(item.Movie)
+				}
+
+			case key.Matches(msg, DefaultKeyMap.AddNew):
+				if !m.moviesList.SettingFilter() {
+					return m, m.addNewMovie()
+				}
+			}
+
+		case stateMovieLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.state = stateMovies
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+		}
+
+	case tea.MouseMsg:
+		switch m.state {
+		case stateMovies:
+			switch msg.Button {
+			case tea.MouseButtonWheelUp:
+				m.moviesList.CursorUp()
+				return m, nil
+
+			case tea.MouseButtonWheelDown:
+				m.moviesList.CursorDown()
+				return m, nil
+
+			case tea.MouseButtonLeft:
+				for i, listItem := range m.moviesList.VisibleItems() {
+					item, _ := listItem.(radarr.MovieItem)
+					if zone.Get(item.Movie.Title).InBounds(msg) {
+						// if we click on an already selected item, open the details
+						if i == m.moviesList.Index() {
+							return m, m.loadMovie(item.Movie)
+						}
+						// else select the item
+						m.moviesList.Select(i)
+						break
+					}
+				}
+			}
+		}
+
+	case radarr.FetchMovieResult:
+		switch m.state {
+		case stateMovieLoading:
+			if msg.Error != nil {
+				m.state = stateMovies
+				return m, statusbar.NewErrCmd("Failed to fetch movie")
+			}
+			m.state = stateMovieDetails
+			m.submodel = movie.New(m.client, m.Width, m.Height)
+
+			return m, m.submodel.Init()
+		}
+
+	case radarr.FetchMoviesResult:
+		if m.state == stateMovieDetails || m.state == stateSearch {
+			break
+		}
+		m.moviesList.StopSpinner()
+
+		m.state = stateMovies
+		if msg.Error != nil {
+			cmds = append(cmds, statusbar.NewErrCmd("Failed to fetch movies"))
+		} else {
+			cmds = append(cmds, m.moviesList.SetItems(msg.Items))
+		}
+		cmds = append(cmds, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()))
+
+		return m, tea.Batch(cmds...)
+
+	case search.MovieAlreadyAddedMsg:
+		switch m.state {
+		case stateSearch:
+			return m, m.loadMovie(msg.Movie)
+		}
+
+	case radarr.AddMovieResult:
+		switch m.state {
+		case stateSearch:
+			m.state = stateMovies
+			if msg.Error != nil {
+				cmds = append(cmds, statusbar.NewErrCmd("Failed to add movie"))
+			} else {
+				cmds = append(cmds,
+					m.moviesList.SetItems(msg.Items),
+					statusbar.NewMessageCmd(fmt.Sprintf("Added Movie: %s", msg.AddedTitle)),
+				)
+			}
+			cmds = append(cmds, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()))
+			return m, tea.Batch(cmds...)
+		}
+
+	case radarr.DeleteMovieResult:
+		switch m.state {
+		case stateMovieDetails:
+			m.state = stateMovies
+			if msg.Error != nil {
+				return m, tea.Batch(
+					statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+					statusbar.NewMessageCmd(msg.Error.Error(), statusbar.WithMessageTimeout(3)),
+				)
+			}
+			return m, tea.Batch(
+				m.moviesList.SetItems(msg.Items),
+				statusbar.NewMessageCmd(fmt.Sprintf("Deleted Movie: %s", msg.DeletedTitle)),
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case stateMovies:
+		var cmd tea.Cmd
+		m.moviesList, cmd = m.moviesList.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case stateMovieLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		cmds = append(cmds, cmd)
+
+	default:
+		var cmd tea.Cmd
+		m.submodel, cmd = m.submodel.Update(msg)
+		cmds = append(cmds, cmd)
+
+		if m.submodel.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.submodel.Back() {
+			m.state = stateMovies
+			cmds = append(cmds,
+				// the movie might have changed in the meantime
+				m.moviesList.SetItems(m.client.MovieItems()),
+				// reset the help of the statusbar
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m *Model) loadMovie(movieResource *radarrAPI.MovieResource) tea.Cmd {
+	m.state = stateMovieLoading
+	m.spinner.Message = common.GetRandomLoadingMessage()
+	m.client.SetMovie(movieResource)
+	return tea.Batch(
+		m.client.ReloadMovie(),
+		m.spinner.Tick,
+	)
+}
+
+func (m *Model) addNewMovie() tea.Cmd {
+	m.state = stateSearch
+	m.submodel = search.New(m.client, m.Width, m.Height)
+
+	return m.submodel.Init()
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height - boxStyle.GetHorizontalFrameSize()
+
+	m.moviesList.SetSize(width, height-boxStyle.GetVerticalFrameSize())
+
+	if m.submodel != nil {
+		m.submodel.SetSize(width, height)
+	}
+}
+
+var boxStyle = lipgloss.NewStyle().
+	Padding(1, 0, 0, 0)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading, stateMovieLoading:
+		return boxStyle.Render(m.spinner.View())
+
+	case stateMovies:
+		return boxStyle.Render(m.moviesList.View())
+
+	default:
+		if m.submodel != nil {
+			return m.submodel.View()
+		}
+		return "unknown state"
+	}
+}
@@ -0,0 +1,119 @@
+package search
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/radarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/radarr/movie"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/muesli/reflow/truncate"
+)
+
+type Delegate struct{}
+
+var (
+	defaultStyle = movie.DefaultStyle.Copy()
+
+	selectedStyle = movie.SelectedStyle.Copy()
+
+	statusStyle = lipgloss.NewStyle().
+			Padding(0, 0, 0, 1).
+			Align(lipgloss.Right)
+)
+
+func (d Delegate) Height() int { return 6 }
+
+func (d Delegate) Spacing() int { return 0 }
+
+func (d Delegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d Delegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	var movie string
+
+	x, _ := defaultStyle.GetFrameSize()
+	itemWidth := m.Width() - x
+	width := itemWidth + defaultStyle.GetHorizontalPadding()
+
+	i, ok := item.(radarr.MovieItem)
+	if ok {
+		movie = renderItem(i, itemWidth, index == m.Index())
+	} else {
+		return
+	}
+
+	if itemWidth-2 <= 0 {
+		// short-circuit
+		return
+	}
+
+	if index == m.Index() {
+		movie = selectedStyle.Width(width).Render(movie)
+	} else {
+		movie = defaultStyle.Width(width).Render(movie)
+	}
+
+	fmt.Fprintf(w, "%s", movie)
+}
+
+var (
+	SelectedForeground = movie.SelectedForeground
+
+	TitleStyle = movie.TitleStyle.Copy()
+
+	Separator = movie.Separator
+)
+
+func renderItem(item radarr.MovieItem, itemWidth int, isSelected bool) string {
+	textColor := SelectedForeground
+	if !isSelected {
+		textColor = styles.SubtleColor
+	}
+
+	status := ""
+	if item.Movie.ID != 0 {
+		status = common.Available
+	}
+	status = statusStyle.Render(status)
+	width := itemWidth - lipgloss.Width(status)
+
+	title := TitleStyle.Foreground(textColor).Render(item.Movie.Title)
+	title = zone.Mark(item.Movie.Title,
+		truncate.StringWithTail(title, uint(width), common.Ellipsis),
+	)
+
+	title = lipgloss.JoinHorizontal(lipgloss.Left,
+		title, lipgloss.PlaceHorizontal(itemWidth-lipgloss.Width(title), lipgloss.Right, status),
+	)
+
+	runtime := "-"
+	if item.Movie.Runtime > 0 {
+		runtime = fmt.Sprintf("%d min", item.Movie.Runtime)
+	}
+	movieStats := lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Foreground(textColor).Render(fmt.Sprint(item.Movie.Year)),
+		Separator,
+		lipgloss.NewStyle().Foreground(textColor).Render(movie.MovieStatus(item.Movie.Status)),
+		Separator,
+		lipgloss.NewStyle().Foreground(textColor).Render(runtime),
+		Separator,
+		lipgloss.NewStyle().Foreground(textColor).Render(item.Movie.Studio),
+	)
+	movieStats = truncate.StringWithTail(movieStats, uint(itemWidth), common.Ellipsis)
+
+	desc := truncate.StringWithTail(item.Movie.Overview, uint(itemWidth)*2, common.Ellipsis)
+	desc = lipgloss.NewStyle().Foreground(textColor).Width(itemWidth).Height(2).MaxHeight(2).Render(desc)
+
+	s := lipgloss.JoinVertical(lipgloss.Top,
+		title,
+		movieStats,
+		desc,
+	)
+
+	return s
+}
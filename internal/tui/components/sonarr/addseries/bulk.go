@@ -0,0 +1,480 @@
+package addseries
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/components/toggle"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/muesli/reflow/truncate"
+)
+
+// BulkEditModel edits multiple series at once.
+// Every option starts as "no change" and is only sent to sonarr if it was set.
+type BulkEditModel struct {
+	common.EmbedableModel
+
+	client    *sonarr.Client
+	seriesIDs []int32
+
+	monitored      *bool
+	seasonFolder   *bool
+	qualityProfile list.Model
+	seriesType     list.Model
+	rootFolder     list.Model
+	moveFiles      toggle.Model
+	tags           list.Model
+	applyTags      sonarrAPI.ApplyTags
+	// tagsChanged is set once the tags or the way they are applied were changed
+	tagsChanged bool
+
+	selectedOption     bulkOption
+	showOptions        bool
+	longestOptionWidth int
+}
+
+type bulkOption int
+
+const (
+	bulkOptionMonitored bulkOption = iota + 1
+	bulkOptionQualityProfile
+	bulkOptionSeriesType
+	bulkOptionSeasonFolder
+	bulkOptionRootFolder
+	bulkOptionMoveFiles
+	bulkOptionTags
+	bulkOptionApplyTags
+	bulkOptionSave
+)
+
+var bulkOptions = map[bulkOption]string{
+	bulkOptionMonitored:      "Monitored",
+	bulkOptionQualityProfile: "Quality Profile",
+	bulkOptionSeriesType:     "Series Type",
+	bulkOptionSeasonFolder:   "Season Folder",
+	bulkOptionRootFolder:     "Root Folder",
+	bulkOptionMoveFiles:      "Move Files",
+	bulkOptionTags:           "Tags",
+	bulkOptionApplyTags:      "Apply Tags",
+	bulkOptionSave:           "",
+}
+
+const noChange = "No change"
+
+func NewBulkEdit(client *sonarr.Client, seriesIDs []int32, width, height int) common.SubModel {
+	m := BulkEditModel{
+		client:             client,
+		seriesIDs:          seriesIDs,
+		selectedOption:     1,
+		longestOptionWidth: getLongestBulkOptionWidth(),
+		qualityProfile: sonarr_list.New(
+			"Select Quality Profile",
+			newQualityProfileItems(client.GetQualityProfiles(), 0),
+			qualityProfileDelegate{},
+			width, height,
+		),
+		seriesType: sonarr_list.New(
+			"Select Series Type",
+			newSeriesTypeItems(""),
+			seriesTypeDelegate{},
+			width, height,
+		),
+		rootFolder: sonarr_list.New(
+			"Select Root Folder",
+			newRootFolderItems(client.GetRootFolders(), ""),
+			rootFolderDelegate{},
+			width, height,
+		),
+		tags: sonarr_list.New(
+			"Select Tags",
+			newTagItems(client.GetTags(), nil),
+			tagDelegate{},
+			width, height,
+		),
+		moveFiles: toggle.New(true),
+		applyTags: sonarrAPI.ApplyTagsAdd,
+	}
+
+	for _, l := range []*list.Model{
+		&m.qualityProfile,
+		&m.seriesType,
+		&m.rootFolder,
+		&m.tags,
+	} {
+		l.SetShowFilter(false)
+		l.SetShowStatusBar(false)
+	}
+
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func getLongestBulkOptionWidth() int {
+	longest := len(noChange)
+	for _, option := range bulkOptions {
+		if len(option) > longest {
+			longest = len(option)
+		}
+	}
+	return longest
+}
+
+func (m BulkEditModel) Init() tea.Cmd {
+	return statusbar.NewHelpCmd(BulkEditKeyMap.FullHelp())
+}
+
+func (m *BulkEditModel) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	if m.showOptions {
+		return m, m.updateOption(msg)
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, BulkEditKeyMap.Down):
+		m.nextOption()
+	case key.Matches(keyMsg, BulkEditKeyMap.Up):
+		m.previousOption()
+	case key.Matches(keyMsg, BulkEditKeyMap.Save):
+		return m, m.save()
+	case key.Matches(keyMsg, BulkEditKeyMap.Reset):
+		m.reset()
+	case key.Matches(keyMsg, BulkEditKeyMap.Back):
+		m.IsBack = true
+	case key.Matches(keyMsg, BulkEditKeyMap.Quit):
+		m.IsQuit = true
+	case key.Matches(keyMsg, BulkEditKeyMap.Select):
+		switch m.selectedOption {
+		case bulkOptionMonitored:
+			m.monitored = cycleBool(m.monitored)
+		case bulkOptionSeasonFolder:
+			m.seasonFolder = cycleBool(m.seasonFolder)
+		case bulkOptionMoveFiles:
+			// moving the files is only possible if the root folder changes
+			if m.selectedRootFolder() != "" {
+				m.moveFiles, _ = m.moveFiles.Update(tea.KeyMsg{Type: tea.KeySpace})
+			}
+		case bulkOptionApplyTags:
+			m.applyTags = nextApplyTags(m.applyTags)
+			m.tagsChanged = true
+		case bulkOptionSave:
+			return m, m.save()
+		default:
+			m.showOptions = true
+		}
+	}
+
+	return m, nil
+}
+
+// updateOption passes the msg to the currently opened picker
+func (m *BulkEditModel) updateOption(msg tea.Msg) tea.Cmd {
+	keyMsg, isKey := msg.(tea.KeyMsg)
+	if isKey && keyMsg.String() == "esc" {
+		m.showOptions = false
+		return nil
+	}
+
+	var l *list.Model
+	switch m.selectedOption {
+	case bulkOptionQualityProfile:
+		l = &m.qualityProfile
+		if isKey && keyMsg.String() == " " {
+			selectQualityProfile(l)
+		}
+
+	case bulkOptionSeriesType:
+		l = &m.seriesType
+		if isKey && keyMsg.String() == " " {
+			selectSeriesType(l)
+		}
+
+	case bulkOptionRootFolder:
+		l = &m.rootFolder
+		if isKey && keyMsg.String() == " " {
+			selectRootFolder(l)
+		}
+
+	case bulkOptionTags:
+		l = &m.tags
+		if isKey && keyMsg.String() == " " {
+			toggleTag(l)
+			m.tagsChanged = true
+		}
+
+	default:
+		return nil
+	}
+
+	var cmd tea.Cmd
+	*l, cmd = l.Update(msg)
+	return cmd
+}
+
+// reset sets the selected option back to "no change"
+func (m *BulkEditModel) reset() {
+	switch m.selectedOption {
+	case bulkOptionMonitored:
+		m.monitored = nil
+	case bulkOptionSeasonFolder:
+		m.seasonFolder = nil
+	case bulkOptionQualityProfile:
+		m.qualityProfile.SetItems(newQualityProfileItems(m.client.GetQualityProfiles(), 0))
+	case bulkOptionSeriesType:
+		m.seriesType.SetItems(newSeriesTypeItems(""))
+	case bulkOptionRootFolder:
+		m.rootFolder.SetItems(newRootFolderItems(m.client.GetRootFolders(), ""))
+	case bulkOptionTags, bulkOptionApplyTags:
+		m.tags.SetItems(newTagItems(m.client.GetTags(), nil))
+		m.applyTags = sonarrAPI.ApplyTagsAdd
+		m.tagsChanged = false
+	}
+}
+
+// cycleBool cycles through no change, yes and no
+func cycleBool(b *bool) *bool {
+	switch {
+	case b == nil:
+		v := true
+		return &v
+	case *b:
+		v := false
+		return &v
+	default:
+		return nil
+	}
+}
+
+func nextApplyTags(applyTags sonarrAPI.ApplyTags) sonarrAPI.ApplyTags {
+	switch applyTags {
+	case sonarrAPI.ApplyTagsAdd:
+		return sonarrAPI.ApplyTagsRemove
+	case sonarrAPI.ApplyTagsRemove:
+		return sonarrAPI.ApplyTagsReplace
+	default:
+		return sonarrAPI.ApplyTagsAdd
+	}
+}
+
+func (m *BulkEditModel) nextOption() {
+	m.selectedOption++
+	if int(m.selectedOption) > len(bulkOptions) {
+		m.selectedOption = 1
+	}
+}
+
+func (m *BulkEditModel) previousOption() {
+	m.selectedOption--
+	if m.selectedOption < 1 {
+		m.selectedOption = bulkOption(len(bulkOptions))
+	}
+}
+
+func (m BulkEditModel) selectedQualityProfile() *sonarrAPI.QualityProfileResource {
+	for _, item := range m.qualityProfile.Items() {
+		if qpItem := item.(qualityProfileItem); qpItem.triggered {
+			return qpItem.qualityProfile
+		}
+	}
+	return nil
+}
+
+func (m BulkEditModel) selectedSeriesType() sonarrAPI.SeriesType {
+	for _, item := range m.seriesType.Items() {
+		if stItem := item.(seriesTypeItem); stItem.triggered {
+			return stItem.seriesType
+		}
+	}
+	return ""
+}
+
+func (m BulkEditModel) selectedRootFolder() string {
+	for _, item := range m.rootFolder.Items() {
+		if rfItem := item.(rootFolderItem); rfItem.triggered {
+			return rfItem.rootFolder.Path
+		}
+	}
+	return ""
+}
+
+func (m BulkEditModel) selectedTags() ([]int32, []string) {
+	ids := make([]int32, 0)
+	var labels []string
+	for _, item := range m.tags.Items() {
+		if tItem := item.(tagItem); tItem.triggered {
+			ids = append(ids, tItem.tag.ID)
+			labels = append(labels, tItem.tag.Label)
+		}
+	}
+	return ids, labels
+}
+
+func (m *BulkEditModel) save() tea.Cmd {
+	editor := sonarrAPI.SeriesEditorResource{
+		SeriesIDs:      m.seriesIDs,
+		Monitored:      m.monitored,
+		SeasonFolder:   m.seasonFolder,
+		SeriesType:     m.selectedSeriesType(),
+		RootFolderPath: m.selectedRootFolder(),
+	}
+	if qp := m.selectedQualityProfile(); qp != nil {
+		editor.QualityProfileID = &qp.ID
+	}
+	if editor.RootFolderPath != "" {
+		editor.MoveFiles = m.moveFiles.Toggled()
+	}
+	if m.tagsChanged {
+		editor.Tags, _ = m.selectedTags()
+		editor.ApplyTags = m.applyTags
+	}
+
+	if editor.Monitored == nil && editor.SeasonFolder == nil && editor.QualityProfileID == nil &&
+		editor.SeriesType == "" && editor.RootFolderPath == "" && editor.Tags == nil {
+		return statusbar.NewErrCmd("Nothing to change")
+	}
+
+	m.IsBack = true
+	return tea.Batch(
+		m.client.BulkEditSeries(&editor),
+		statusbar.NewMessageCmd(fmt.Sprintf("Saving %d series...", len(m.seriesIDs)), statusbar.WithMessageTimeout(2)),
+	)
+}
+
+func (m BulkEditModel) View() string {
+	if !m.showOptions {
+		return m.optionsView()
+	}
+
+	switch m.selectedOption {
+	case bulkOptionQualityProfile:
+		return boxStyle.Width(m.Width - 2).Render(m.qualityProfile.View())
+	case bulkOptionSeriesType:
+		return boxStyle.Width(m.Width - 2).Render(m.seriesType.View())
+	case bulkOptionRootFolder:
+		return boxStyle.Width(m.Width - 2).Render(m.rootFolder.View())
+	case bulkOptionTags:
+		return boxStyle.Width(m.Width - 2).Render(m.tags.View())
+	default:
+		return m.optionsView()
+	}
+}
+
+var noChangeStyle = lipgloss.NewStyle().Foreground(styles.SubtleColor)
+
+func boolValue(b *bool) string {
+	switch {
+	case b == nil:
+		return noChangeStyle.Render(noChange)
+	case *b:
+		return "Yes"
+	default:
+		return "No"
+	}
+}
+
+func (m BulkEditModel) optionsView() string {
+	var s strings.Builder
+
+	valueWidth := max(min(m.Width-m.longestOptionWidth-keyStyle.GetHorizontalMargins()-valueStyle.GetHorizontalFrameSize(), maxValueWidth), m.longestOptionWidth)
+
+	qualityProfile := noChangeStyle.Render(noChange)
+	if qp := m.selectedQualityProfile(); qp != nil {
+		qualityProfile = qp.Name
+	}
+	seriesType := noChangeStyle.Render(noChange)
+	if st := m.selectedSeriesType(); st != "" {
+		seriesType = string(st)
+	}
+	rootFolder := noChangeStyle.Render(noChange)
+	moveFiles := noChangeStyle.Render("root folder unchanged")
+	if rf := m.selectedRootFolder(); rf != "" {
+		rootFolder = truncate.StringWithTail(rf, uint(valueWidth), "…")
+		moveFiles = m.moveFiles.View()
+	}
+	tags := noChangeStyle.Render(noChange)
+	applyTags := noChangeStyle.Render(noChange)
+	if m.tagsChanged {
+		_, labels := m.selectedTags()
+		tags = "None"
+		if len(labels) > 0 {
+			tags = truncate.StringWithTail(strings.Join(labels, ", "), uint(valueWidth), "…")
+		}
+		applyTags = common.Title(string(m.applyTags))
+	}
+
+	kvs := [][]string{
+		{bulkOptions[bulkOptionMonitored], boolValue(m.monitored)},
+		{bulkOptions[bulkOptionQualityProfile], qualityProfile},
+		{bulkOptions[bulkOptionSeriesType], seriesType},
+		{bulkOptions[bulkOptionSeasonFolder], boolValue(m.seasonFolder)},
+		{bulkOptions[bulkOptionRootFolder], rootFolder},
+		{bulkOptions[bulkOptionMoveFiles], moveFiles},
+		{bulkOptions[bulkOptionTags], tags},
+		{bulkOptions[bulkOptionApplyTags], applyTags},
+	}
+
+	lines := make([]string, len(kvs))
+	for i, kv := range kvs {
+		var color lipgloss.TerminalColor = styles.SubtleColor
+		if i == int(m.selectedOption)-1 {
+			color = styles.SonarrBlue
+		}
+		lines[i] = lipgloss.JoinHorizontal(lipgloss.Left,
+			keyStyle.Width(m.longestOptionWidth).Render(kv[0]),
+			valueStyle.Width(valueWidth).BorderForeground(color).Render(kv[1]),
+		)
+	}
+
+	options := lipgloss.JoinVertical(lipgloss.Right,
+		lines...,
+	)
+
+	width := lipgloss.Width(options)
+	s.WriteString(
+		titleStyle.Width(width).Render(fmt.Sprintf("Edit %d series", len(m.seriesIDs))),
+	)
+	s.WriteByte('\n')
+	s.WriteByte('\n')
+
+	s.WriteString(options)
+
+	s.WriteByte('\n')
+	s.WriteByte('\n')
+
+	var color lipgloss.TerminalColor = styles.SubtleColor
+	if m.selectedOption == bulkOptionSave {
+		color = styles.SonarrBlue
+	}
+	s.WriteString(
+		lipgloss.Place(width, 1, lipgloss.Center,
+			lipgloss.Top, buttonStyle.BorderForeground(color).Render("Save")),
+	)
+
+	return boxStyle.MaxWidth(m.Width + boxStyle.GetHorizontalFrameSize()).Render(s.String())
+}
+
+func (m *BulkEditModel) SetSize(width, height int) {
+	width -= boxStyle.GetHorizontalFrameSize()
+	height -= boxStyle.GetVerticalFrameSize()
+
+	m.Width = width
+	m.Height = height
+
+	m.qualityProfile.SetSize(width, height)
+	m.seriesType.SetSize(width, height)
+	m.rootFolder.SetSize(width, height)
+	m.tags.SetSize(width, height)
+}
@@ -0,0 +1,452 @@
+package addseries
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/components/toggle"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/muesli/reflow/truncate"
+)
+
+// EditModel edits the settings of an existing series
+type EditModel struct {
+	common.EmbedableModel
+
+	client *sonarr.Client
+	// series is a copy of the series which is modified until the changes are saved
+	series *sonarrAPI.SeriesResource
+	// originalPath is used to detect if the files must be moved
+	originalPath string
+
+	path            textinput.Model
+	rootFolder      list.Model
+	qualityProfile  list.Model
+	languageProfile list.Model
+	seriesType      list.Model
+	tags            list.Model
+	seasonFolder    toggle.Model
+	moveFiles       toggle.Model
+
+	selectedOption     editOption
+	showOptions        bool
+	longestOptionWidth int
+}
+
+type editOption int
+
+const (
+	editOptionPath editOption = iota + 1
+	editOptionRootFolder
+	editOptionQualityProfile
+	editOptionLanguageProfile
+	editOptionSeriesType
+	editOptionSeasonFolder
+	editOptionTags
+	editOptionMoveFiles
+	editOptionSave
+)
+
+var editOptions = map[editOption]string{
+	editOptionPath:            "Path",
+	editOptionRootFolder:      "Root Folder",
+	editOptionQualityProfile:  "Quality Profile",
+	editOptionLanguageProfile: "Language Profile",
+	editOptionSeriesType:      "Series Type",
+	editOptionSeasonFolder:    "Season Folder",
+	editOptionTags:            "Tags",
+	editOptionMoveFiles:       "Move Files",
+	editOptionSave:            "",
+}
+
+// maxValueWidth limits the width of the values, so the dialog doesn't get too wide for long paths
+const maxValueWidth = 50
+
+func NewEdit(client *sonarr.Client, series *sonarrAPI.SeriesResource, width, height int) common.SubModel {
+	// edit a copy, so the changes are discarded if the dialog is closed without saving
+	serie := *series
+	serie.Tags = append([]int32{}, series.Tags...)
+
+	m := EditModel{
+		client:             client,
+		series:             &serie,
+		originalPath:       serie.Path,
+		path:               textinput.New(),
+		selectedOption:     1,
+		longestOptionWidth: getLongestEditOptionWidth(),
+		rootFolder: sonarr_list.New(
+			"Select Root Folder",
+			newRootFolderItems(client.GetRootFolders(), serie.RootFolderPath),
+			rootFolderDelegate{},
+			width, height,
+		),
+		qualityProfile: sonarr_list.New(
+			"Select Quality Profile",
+			newQualityProfileItems(client.GetQualityProfiles(), serie.QualityProfileID),
+			qualityProfileDelegate{},
+			width, height,
+		),
+		languageProfile: sonarr_list.New(
+			"Select Language Profile",
+			newLanguageProfileItems(client.GetLanguageProfiles(), serie.LanguageProfileID),
+			languageProfileDelegate{},
+			width, height,
+		),
+		seriesType: sonarr_list.New(
+			"Select Series Type",
+			newSeriesTypeItems(serie.SeriesType),
+			seriesTypeDelegate{},
+			width, height,
+		),
+		tags: sonarr_list.New(
+			"Select Tags",
+			newTagItems(client.GetTags(), serie.Tags),
+			tagDelegate{},
+			width, height,
+		),
+		seasonFolder: toggle.New(serie.SeasonFolder),
+		moveFiles:    toggle.New(true),
+	}
+
+	m.path.Prompt = ""
+	m.path.SetValue(serie.Path)
+	m.path.Cursor.Style = lipgloss.NewStyle().Foreground(styles.SonarrBlue)
+
+	for _, l := range []*list.Model{
+		&m.rootFolder,
+		&m.qualityProfile,
+		&m.languageProfile,
+		&m.seriesType,
+		&m.tags,
+	} {
+		l.SetShowFilter(false)
+		l.SetShowStatusBar(false)
+	}
+
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func getLongestEditOptionWidth() int {
+	var longest int
+	for _, option := range editOptions {
+		if len(option) > longest {
+			longest = len(option)
+		}
+	}
+	return longest
+}
+
+func (m EditModel) Init() tea.Cmd {
+	return statusbar.NewHelpCmd(EditKeyMap.FullHelp())
+}
+
+func (m *EditModel) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	if m.showOptions {
+		return m, m.updateOption(msg)
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, EditKeyMap.Down):
+			m.nextOption()
+		case key.Matches(msg, EditKeyMap.Up):
+			m.previousOption()
+		case key.Matches(msg, EditKeyMap.Select):
+			switch m.selectedOption {
+			case editOptionSeasonFolder, editOptionMoveFiles:
+				// no-op
+			case editOptionSave:
+				return m, m.save()
+			case editOptionPath:
+				m.showOptions = true
+				return m, m.path.Focus()
+			default:
+				m.showOptions = true
+			}
+		case key.Matches(msg, EditKeyMap.Save):
+			return m, m.save()
+		case key.Matches(msg, EditKeyMap.Back):
+			m.IsBack = true
+		case key.Matches(msg, EditKeyMap.Quit):
+			m.IsQuit = true
+		}
+	}
+
+	switch m.selectedOption {
+	case editOptionSeasonFolder:
+		var cmd tea.Cmd
+		m.seasonFolder, cmd = m.seasonFolder.Update(msg)
+		m.series.SeasonFolder = m.seasonFolder.Toggled()
+		return m, cmd
+
+	case editOptionMoveFiles:
+		// moving the files is only possible if the path changed
+		if !m.pathChanged() {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.moveFiles, cmd = m.moveFiles.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// updateOption passes the msg to the currently opened option
+func (m *EditModel) updateOption(msg tea.Msg) tea.Cmd {
+	keyMsg, isKey := msg.(tea.KeyMsg)
+
+	if m.selectedOption == editOptionPath {
+		if isKey && (keyMsg.Type == tea.KeyEnter || keyMsg.Type == tea.KeyEsc) {
+			m.path.Blur()
+			m.showOptions = false
+			return nil
+		}
+		var cmd tea.Cmd
+		m.path, cmd = m.path.Update(msg)
+		return cmd
+	}
+
+	if isKey && keyMsg.String() == "esc" {
+		m.showOptions = false
+		return nil
+	}
+
+	var l *list.Model
+	switch m.selectedOption {
+	case editOptionRootFolder:
+		l = &m.rootFolder
+		if isKey && keyMsg.String() == " " {
+			if rootFolder := selectRootFolder(l); rootFolder != nil {
+				m.series.RootFolderPath = rootFolder.Path
+				m.path.SetValue(joinPath(rootFolder.Path, folderName(m.originalPath)))
+			}
+		}
+
+	case editOptionQualityProfile:
+		l = &m.qualityProfile
+		if isKey && keyMsg.String() == " " {
+			if qualityProfile := selectQualityProfile(l); qualityProfile != nil {
+				m.series.QualityProfileID = qualityProfile.ID
+			}
+		}
+
+	case editOptionLanguageProfile:
+		l = &m.languageProfile
+		if isKey && keyMsg.String() == " " {
+			if languageProfile := selectLanguageProfile(l); languageProfile != nil {
+				m.series.LanguageProfileID = languageProfile.ID
+			}
+		}
+
+	case editOptionSeriesType:
+		l = &m.seriesType
+		if isKey && keyMsg.String() == " " {
+			m.series.SeriesType = selectSeriesType(l)
+		}
+
+	case editOptionTags:
+		l = &m.tags
+		if isKey && keyMsg.String() == " " {
+			m.series.Tags = toggleTag(l)
+		}
+
+	default:
+		return nil
+	}
+
+	var cmd tea.Cmd
+	*l, cmd = l.Update(msg)
+	return cmd
+}
+
+func (m *EditModel) nextOption() {
+	m.selectedOption++
+	if int(m.selectedOption) > len(editOptions) {
+		m.selectedOption = 1
+	}
+}
+
+func (m *EditModel) previousOption() {
+	m.selectedOption--
+	if m.selectedOption < 1 {
+		m.selectedOption = editOption(len(editOptions))
+	}
+}
+
+func (m EditModel) pathChanged() bool {
+	return strings.TrimSpace(m.path.Value()) != m.originalPath
+}
+
+func (m *EditModel) save() tea.Cmd {
+	path := strings.TrimSpace(m.path.Value())
+	if path == "" {
+		return statusbar.NewErrCmd("The path of the series can't be empty")
+	}
+	moveFiles := m.pathChanged() && m.moveFiles.Toggled()
+	m.series.Path = path
+
+	m.IsBack = true
+	return tea.Batch(
+		m.client.EditSeries(m.series, moveFiles),
+		statusbar.NewMessageCmd(fmt.Sprintf("Saving %s...", m.series.Title), statusbar.WithMessageTimeout(2)),
+	)
+}
+
+// folderName returns the last element of a path, sonarr might run on windows so both separators are supported
+func folderName(path string) string {
+	path = strings.TrimRight(path, `/\`)
+	return path[strings.LastIndexAny(path, `/\`)+1:]
+}
+
+// joinPath joins the root folder and the folder of the series using the separator of the root folder
+func joinPath(root, folder string) string {
+	sep := "/"
+	if strings.Contains(root, `\`) {
+		sep = `\`
+	}
+	return strings.TrimRight(root, `/\`) + sep + folder
+}
+
+func (m EditModel) View() string {
+	if !m.showOptions || m.selectedOption == editOptionPath {
+		return m.optionsView()
+	}
+
+	switch m.selectedOption {
+	case editOptionRootFolder:
+		return boxStyle.Width(m.Width - 2).Render(m.rootFolder.View())
+	case editOptionQualityProfile:
+		return boxStyle.Width(m.Width - 2).Render(m.qualityProfile.View())
+	case editOptionLanguageProfile:
+		return boxStyle.Width(m.Width - 2).Render(m.languageProfile.View())
+	case editOptionSeriesType:
+		return boxStyle.Width(m.Width - 2).Render(m.seriesType.View())
+	case editOptionTags:
+		return boxStyle.Width(m.Width - 2).Render(m.tags.View())
+	default:
+		return m.optionsView()
+	}
+}
+
+func (m EditModel) valueWidth() int {
+	width := m.Width - m.longestOptionWidth - keyStyle.GetHorizontalMargins() - valueStyle.GetHorizontalFrameSize()
+	return max(min(width, maxValueWidth), m.longestOptionWidth)
+}
+
+func (m EditModel) tagLabels() string {
+	var labels []string
+	for _, item := range m.tags.Items() {
+		if tItem := item.(tagItem); tItem.triggered {
+			labels = append(labels, tItem.tag.Label)
+		}
+	}
+	if len(labels) == 0 {
+		return "None"
+	}
+	return strings.Join(labels, ", ")
+}
+
+func (m EditModel) optionsView() string {
+	var s strings.Builder
+
+	valueWidth := m.valueWidth()
+
+	path := m.path.View()
+	if !m.path.Focused() {
+		path = truncate.StringWithTail(m.path.Value(), uint(valueWidth), "…")
+	}
+
+	moveFiles := lipgloss.NewStyle().Foreground(styles.SubtleColor).Render("path unchanged")
+	if m.pathChanged() {
+		moveFiles = m.moveFiles.View()
+	}
+
+	var qualityProfile string
+	if qp := m.client.GetQualityProfileByID(m.series.QualityProfileID); qp != nil {
+		qualityProfile = qp.Name
+	}
+	var languageProfile string
+	if lp := m.client.GetLanguageProfileByID(m.series.LanguageProfileID); lp != nil {
+		languageProfile = lp.Name
+	}
+
+	kvs := [][]string{
+		{editOptions[editOptionPath], path},
+		{editOptions[editOptionRootFolder], truncate.StringWithTail(m.series.RootFolderPath, uint(valueWidth), "…")},
+		{editOptions[editOptionQualityProfile], qualityProfile},
+		{editOptions[editOptionLanguageProfile], languageProfile},
+		{editOptions[editOptionSeriesType], string(m.series.SeriesType)},
+		{editOptions[editOptionSeasonFolder], m.seasonFolder.View()},
+		{editOptions[editOptionTags], truncate.StringWithTail(m.tagLabels(), uint(valueWidth), "…")},
+		{editOptions[editOptionMoveFiles], moveFiles},
+	}
+
+	lines := make([]string, len(kvs))
+	for i, kv := range kvs {
+		var color lipgloss.TerminalColor = styles.SubtleColor
+		if i == int(m.selectedOption)-1 {
+			color = styles.SonarrBlue
+		}
+		lines[i] = lipgloss.JoinHorizontal(lipgloss.Left,
+			keyStyle.Width(m.longestOptionWidth).Render(kv[0]),
+			valueStyle.Width(valueWidth).BorderForeground(color).Render(kv[1]),
+		)
+	}
+
+	options := lipgloss.JoinVertical(lipgloss.Right,
+		lines...,
+	)
+
+	width := lipgloss.Width(options)
+	s.WriteString(
+		titleStyle.Width(width).Render(fmt.Sprintf("Edit %s", m.series.Title)),
+	)
+	s.WriteByte('\n')
+	s.WriteByte('\n')
+
+	s.WriteString(options)
+
+	s.WriteByte('\n')
+	s.WriteByte('\n')
+
+	var color lipgloss.TerminalColor = styles.SubtleColor
+	if m.selectedOption == editOptionSave {
+		color = styles.SonarrBlue
+	}
+	s.WriteString(
+		lipgloss.Place(width, 1, lipgloss.Center,
+			lipgloss.Top, buttonStyle.BorderForeground(color).Render("Save")),
+	)
+
+	return boxStyle.MaxWidth(m.Width + boxStyle.GetHorizontalFrameSize()).Render(s.String())
+}
+
+func (m *EditModel) SetSize(width, height int) {
+	width -= boxStyle.GetHorizontalFrameSize()
+	height -= boxStyle.GetVerticalFrameSize()
+
+	m.Width = width
+	m.Height = height
+
+	m.path.Width = m.valueWidth() - 1
+
+	m.rootFolder.SetSize(width, height)
+	m.qualityProfile.SetSize(width, height)
+	m.languageProfile.SetSize(width, height)
+	m.seriesType.SetSize(width, height)
+	m.tags.SetSize(width, height)
+}
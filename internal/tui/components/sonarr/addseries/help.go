@@ -31,3 +31,65 @@ func (k defaultKeyMap) FullHelp() [][]key.Binding {
 		{k.Help, k.Quit},
 	}
 }
+
+type editKeyMap struct {
+	Quit   key.Binding
+	Back   key.Binding
+	Help   key.Binding
+	Select key.Binding
+	Toggle key.Binding
+	Save   key.Binding
+	Up     key.Binding
+	Down   key.Binding
+}
+
+var EditKeyMap = editKeyMap{
+	Quit:   key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+	Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	Help:   key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+	Select: key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("enter", "select")),
+	Toggle: key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle")),
+	Save:   key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "save")),
+	Up:     key.NewBinding(key.WithKeys("k", "up"), key.WithHelp("k", "up")),
+	Down:   key.NewBinding(key.WithKeys("j", "down"), key.WithHelp("j", "down")),
+}
+
+func (k editKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Select, k.Toggle},
+		{k.Save, k.Back},
+		{k.Help, k.Quit},
+	}
+}
+
+type bulkEditKeyMap struct {
+	Quit   key.Binding
+	Back   key.Binding
+	Help   key.Binding
+	Select key.Binding
+	Toggle key.Binding
+	Reset  key.Binding
+	Save   key.Binding
+	Up     key.Binding
+	Down   key.Binding
+}
+
+var BulkEditKeyMap = bulkEditKeyMap{
+	Quit:   key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+	Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	Help:   key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+	Select: key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("enter", "select")),
+	Toggle: key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle")),
+	Reset:  key.NewBinding(key.WithKeys("backspace", "delete"), key.WithHelp("backspace", "no change")),
+	Save:   key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "save")),
+	Up:     key.NewBinding(key.WithKeys("k", "up"), key.WithHelp("k", "up")),
+	Down:   key.NewBinding(key.WithKeys("j", "down"), key.WithHelp("j", "down")),
+}
+
+func (k bulkEditKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Select, k.Toggle, k.Reset},
+		{k.Save, k.Back},
+		{k.Help, k.Quit},
+	}
+}
@@ -14,23 +14,32 @@ type languageProfileItem struct {
 	triggered       bool
 }
 
-func newLanguageProfileItems(languageProfiles []*sonarrAPI.LanguageProfileResource) []list.Item {
+func newLanguageProfileItems(languageProfiles []*sonarrAPI.LanguageProfileResource, selectedID int32) []list.Item {
 	items := make([]list.Item, len(languageProfiles))
 	for i, languageProfile := range languageProfiles {
-		var triggered bool
-		if i == 0 {
-			triggered = true
-		}
-
 		items[i] = languageProfileItem{
 			languageProfile: languageProfile,
-			triggered:       triggered,
+			triggered:       languageProfile.ID == selectedID,
 		}
 	}
 
 	return items
 }
 
+// selectLanguageProfile triggers the language profile at the cursor and returns it
+func selectLanguageProfile(l *list.Model) *sonarrAPI.LanguageProfileResource {
+	var selected *sonarrAPI.LanguageProfileResource
+	for i, item := range l.Items() {
+		lpItem := item.(languageProfileItem)
+		lpItem.triggered = i == l.Index()
+		if lpItem.triggered {
+			selected = lpItem.languageProfile
+		}
+		l.SetItem(i, lpItem)
+	}
+	return selected
+}
+
 func (d languageProfileItem) FilterValue() string { return "" }
 
 type languageProfileDelegate struct{}
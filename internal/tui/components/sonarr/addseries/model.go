@@ -73,7 +73,7 @@ func New(client *sonarr.Client, series *sonarrAPI.SeriesResource, width, height
 		longestOptionWidth: getLongestOptionWidth(),
 		rootFolder: sonarr_list.New(
 			"Select Root Folder",
-			newRootFolderItems(client.GetRootFolders()),
+			newRootFolderItems(client.GetRootFolders(), series.RootFolderPath),
 			rootFolderDelegate{},
 			width, height,
 		),
@@ -85,19 +85,19 @@ func New(client *sonarr.Client, series *sonarrAPI.SeriesResource, width, height
 		),
 		qualityProfile: sonarr_list.New(
 			"Select Quality Profile",
-			newQualityProfileItems(client.GetQualityProfiles()),
+			newQualityProfileItems(client.GetQualityProfiles(), series.QualityProfileID),
 			qualityProfileDelegate{},
 			width, height,
 		),
 		languageProfile: sonarr_list.New(
 			"Select Language Profile",
-			newLanguageProfileItems(client.GetLanguageProfiles()),
+			newLanguageProfileItems(client.GetLanguageProfiles(), series.LanguageProfileID),
 			languageProfileDelegate{},
 			width, height,
 		),
 		seriesType: sonarr_list.New(
 			"Select Series Type",
-			newSeriesTypeItems(),
+			newSeriesTypeItems(series.SeriesType),
 			seriesTypeDelegate{},
 			width, height,
 		),
@@ -201,15 +201,8 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 			case tea.KeyMsg:
 				switch msg.String() {
 				case " ":
-					for i, item := range m.rootFolder.Items() {
-						rfItem := item.(rootFolderItem)
-						if i == m.rootFolder.Index() {
-							rfItem.triggered = true
-							m.series.RootFolderPath = rfItem.rootFolder.Path
-						} else {
-							rfItem.triggered = false
-						}
-						m.rootFolder.SetItem(i, rfItem)
+					if rootFolder := selectRootFolder(&m.rootFolder); rootFolder != nil {
+						m.series.RootFolderPath = rootFolder.Path
 					}
 				}
 			}
@@ -248,15 +241,8 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 			case tea.KeyMsg:
 				switch msg.String() {
 				case " ":
-					for i, item := range m.qualityProfile.Items() {
-						qpItem := item.(qualityProfileItem)
-						if i == m.qualityProfile.Index() {
-							qpItem.triggered = true
-							m.series.QualityProfileID = qpItem.qualityProfile.ID
-						} else {
-							qpItem.triggered = false
-						}
-						m.qualityProfile.SetItem(i, qpItem)
+					if qualityProfile := selectQualityProfile(&m.qualityProfile); qualityProfile != nil {
+						m.series.QualityProfileID = qualityProfile.ID
 					}
 				}
 			}
@@ -270,15 +256,8 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 			case tea.KeyMsg:
 				switch msg.String() {
 				case " ":
-					for i, item := range m.languageProfile.Items() {
-						lpItem := item.(languageProfileItem)
-						if i == m.languageProfile.Index() {
-							lpItem.triggered = true
-							m.series.LanguageProfileID = lpItem.languageProfile.ID
-						} else {
-							lpItem.triggered = false
-						}
-						m.languageProfile.SetItem(i, lpItem)
+					if languageProfile := selectLanguageProfile(&m.languageProfile); languageProfile != nil {
+						m.series.LanguageProfileID = languageProfile.ID
 					}
 				}
 			}
@@ -292,16 +271,7 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 			case tea.KeyMsg:
 				switch msg.String() {
 				case " ":
-					for i, item := range m.seriesType.Items() {
-						stItem := item.(seriesTypeItem)
-						if i == m.seriesType.Index() {
-							stItem.triggered = true
-							m.series.SeriesType = stItem.seriesType
-						} else {
-							stItem.triggered = false
-						}
-						m.seriesType.SetItem(i, stItem)
-					}
+					m.series.SeriesType = selectSeriesType(&m.seriesType)
 				}
 			}
 
@@ -14,23 +14,32 @@ type qualityProfileItem struct {
 	triggered      bool
 }
 
-func newQualityProfileItems(qualityProfiles []*sonarrAPI.QualityProfileResource) []list.Item {
+func newQualityProfileItems(qualityProfiles []*sonarrAPI.QualityProfileResource, selectedID int32) []list.Item {
 	items := make([]list.Item, len(qualityProfiles))
 	for i, qualityProfile := range qualityProfiles {
-		var triggered bool
-		if i == 0 {
-			triggered = true
-		}
-
 		items[i] = qualityProfileItem{
 			qualityProfile: qualityProfile,
-			triggered:      triggered,
+			triggered:      qualityProfile.ID == selectedID,
 		}
 	}
 
 	return items
 }
 
+// selectQualityProfile triggers the quality profile at the cursor and returns it
+func selectQualityProfile(l *list.Model) *sonarrAPI.QualityProfileResource {
+	var selected *sonarrAPI.QualityProfileResource
+	for i, item := range l.Items() {
+		qpItem := item.(qualityProfileItem)
+		qpItem.triggered = i == l.Index()
+		if qpItem.triggered {
+			selected = qpItem.qualityProfile
+		}
+		l.SetItem(i, qpItem)
+	}
+	return selected
+}
+
 func (d qualityProfileItem) FilterValue() string { return "" }
 
 type qualityProfileDelegate struct{}
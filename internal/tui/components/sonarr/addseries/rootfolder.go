@@ -15,23 +15,32 @@ type rootFolderItem struct {
 	triggered  bool
 }
 
-func newRootFolderItems(rootFolders []*sonarrAPI.RootFolderResource) []list.Item {
+func newRootFolderItems(rootFolders []*sonarrAPI.RootFolderResource, selectedPath string) []list.Item {
 	items := make([]list.Item, len(rootFolders))
 	for i, rootFolder := range rootFolders {
-		var triggered bool
-		if i == 0 {
-			triggered = true
-		}
-
 		items[i] = rootFolderItem{
 			rootFolder: rootFolder,
-			triggered:  triggered,
+			triggered:  rootFolder.Path == selectedPath,
 		}
 	}
 
 	return items
 }
 
+// selectRootFolder triggers the root folder at the cursor and returns it
+func selectRootFolder(l *list.Model) *sonarrAPI.RootFolderResource {
+	var selected *sonarrAPI.RootFolderResource
+	for i, item := range l.Items() {
+		rfItem := item.(rootFolderItem)
+		rfItem.triggered = i == l.Index()
+		if rfItem.triggered {
+			selected = rfItem.rootFolder
+		}
+		l.SetItem(i, rfItem)
+	}
+	return selected
+}
+
 func (d rootFolderItem) FilterValue() string { return "" }
 
 type rootFolderDelegate struct{}
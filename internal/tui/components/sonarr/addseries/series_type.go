@@ -14,7 +14,7 @@ type seriesTypeItem struct {
 	triggered  bool
 }
 
-func newSeriesTypeItems() []list.Item {
+func newSeriesTypeItems(selected sonarrAPI.SeriesType) []list.Item {
 	items := [...]sonarrAPI.SeriesType{
 		sonarrAPI.Standard,
 		sonarrAPI.Daily,
@@ -23,18 +23,28 @@ func newSeriesTypeItems() []list.Item {
 
 	listItems := make([]list.Item, len(items))
 	for i, item := range items {
-		var triggered bool
-		if i == 0 {
-			triggered = true
-		}
 		listItems[i] = seriesTypeItem{
 			seriesType: item,
-			triggered:  triggered,
+			triggered:  item == selected,
 		}
 	}
 	return listItems
 }
 
+// selectSeriesType triggers the series type at the cursor and returns it
+func selectSeriesType(l *list.Model) sonarrAPI.SeriesType {
+	var selected sonarrAPI.SeriesType
+	for i, item := range l.Items() {
+		stItem := item.(seriesTypeItem)
+		stItem.triggered = i == l.Index()
+		if stItem.triggered {
+			selected = stItem.seriesType
+		}
+		l.SetItem(i, stItem)
+	}
+	return selected
+}
+
 func (d seriesTypeItem) FilterValue() string { return "" }
 
 type seriesTypeDelegate struct{}
@@ -0,0 +1,88 @@
+package addseries
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type tagItem struct {
+	tag       *sonarrAPI.TagResource
+	triggered bool
+}
+
+func newTagItems(tags []*sonarrAPI.TagResource, selected []int32) []list.Item {
+	items := make([]list.Item, len(tags))
+	for i, tag := range tags {
+		var triggered bool
+		for _, id := range selected {
+			if tag.ID == id {
+				triggered = true
+				break
+			}
+		}
+
+		items[i] = tagItem{
+			tag:       tag,
+			triggered: triggered,
+		}
+	}
+
+	return items
+}
+
+// toggleTag toggles the tag at the cursor and returns the ids of all triggered tags
+func toggleTag(l *list.Model) []int32 {
+	ids := make([]int32, 0)
+	for i, item := range l.Items() {
+		tItem := item.(tagItem)
+		if i == l.Index() {
+			tItem.triggered = !tItem.triggered
+			l.SetItem(i, tItem)
+		}
+		if tItem.triggered {
+			ids = append(ids, tItem.tag.ID)
+		}
+	}
+	return ids
+}
+
+func (d tagItem) FilterValue() string { return "" }
+
+type tagDelegate struct{}
+
+func (d tagDelegate) Height() int { return 1 }
+
+func (d tagDelegate) Spacing() int { return 0 }
+
+func (d tagDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(tagItem)
+	if !ok {
+		return
+	}
+
+	var text string
+	if i.triggered {
+		text = fmt.Sprintf("✅ %s", i.tag.Label)
+	} else {
+		text = fmt.Sprintf("⬜ %s", i.tag.Label)
+	}
+
+	var (
+		isSelected = index == m.Index()
+		title      string
+	)
+
+	if isSelected {
+		title = itemStyles.SelectedTitle.Render(text)
+	} else {
+		title = itemStyles.NormalTitle.Render(text)
+	}
+
+	fmt.Fprint(w, title)
+}
+
+func (d tagDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
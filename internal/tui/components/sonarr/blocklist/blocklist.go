@@ -0,0 +1,462 @@
+package blocklist
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/confirm"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/muesli/reflow/truncate"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateBlocklist
+	stateConfirm
+)
+
+// reasonWidth is the maximal width of the reason in the table, the full reason is shown in the details
+const reasonWidth = 30
+
+type Model struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	state   state
+	spinner common.Spinner
+	table   table.Model
+	confirm common.SubModel
+
+	blocklist *sonarrAPI.BlocklistResourcePagingResource
+	episodes  map[int32]*sonarrAPI.EpisodeResource
+	pager     common.Pager
+	// selected contains the ids of the marked items, the marks are kept when switching pages
+	selected common.Selection[int32]
+}
+
+func New(client *sonarr.Client, width, height int) *Model {
+	m := Model{
+		client:   client,
+		state:    stateLoading,
+		spinner:  common.NewSpinner(),
+		table:    common.NewTable(),
+		pager:    common.NewPager(sonarr.BlocklistPageSize),
+		selected: make(common.Selection[int32]),
+	}
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Title() string {
+	return "Blocklist"
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.client.FetchBlocklist(m.pager.Page),
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.TabModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+
+		case stateBlocklist:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				return m, tea.Batch(
+					m.client.FetchBlocklist(m.pager.Page),
+					statusbar.NewMessageCmd("Reloading...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.NextPage):
+				if m.pager.NextPage() {
+					return m, m.client.FetchBlocklist(m.pager.Page)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.PrevPage):
+				if m.pager.PrevPage() {
+					return m, m.client.FetchBlocklist(m.pager.Page)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Toggle):
+				if item := m.selectedItem(); item != nil {
+					m.selected.Toggle(item.ID)
+					m.updateTable()
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.ToggleAll):
+				records := m.records()
+				ids := make([]int32, len(records))
+				for i, item := range records {
+					ids[i] = item.ID
+				}
+				m.selected.ToggleAll(ids)
+				m.updateTable()
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Remove):
+				if ids := m.targetIDs(); len(ids) > 0 {
+					return m, m.confirmRemove(ids)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Clear):
+				if m.totalRecords() > 0 {
+					return m, m.confirmClear()
+				}
+				return m, nil
+			}
+		}
+
+	case sonarr.FetchBlocklistResult:
+		if m.state == stateLoading {
+			m.state = stateBlocklist
+		}
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch blocklist")
+		}
+		m.blocklist = msg.Blocklist
+		m.episodes = msg.Episodes
+		// the page might not exist anymore after items were removed
+		if m.pager.SetTotal(int(m.blocklist.TotalRecords)) {
+			return m, m.client.FetchBlocklist(m.pager.Page)
+		}
+		m.updateTable()
+		return m, nil
+
+	case sonarr.DeleteBlocklistResult:
+		if msg.Error != nil {
+			if msg.All {
+				return m, statusbar.NewErrCmd("Failed to clear blocklist")
+			}
+			return m, statusbar.NewErrCmd(fmt.Sprintf("Failed to remove %s from the blocklist", itemCount(msg.Count)))
+		}
+		m.selected.Clear()
+		message := fmt.Sprintf("Removed %s from the blocklist", itemCount(msg.Count))
+		if msg.All {
+			m.pager.Page = 1
+			message = "Cleared blocklist"
+		}
+		return m, tea.Batch(
+			statusbar.NewMessageCmd(message),
+			m.client.FetchBlocklist(m.pager.Page),
+		)
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateBlocklist:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+
+	case stateConfirm:
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+
+		if m.confirm.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.confirm.Back() {
+			m.state = stateBlocklist
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m *Model) confirmRemove(ids []int32) tea.Cmd {
+	question := fmt.Sprintf("Remove %s from the blocklist? Sonarr might grab them again.", itemCount(len(ids)))
+	if item := m.selectedItem(); len(ids) == 1 && item != nil && item.ID == ids[0] {
+		question = fmt.Sprintf("Remove %q from the blocklist? Sonarr might grab it again.", item.SourceTitle)
+	}
+
+	m.state = stateConfirm
+	m.confirm = confirm.New("Remove from blocklist", question, m.client.DeleteBlocklistItems(ids), styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.confirm.Init()
+}
+
+func (m *Model) confirmClear() tea.Cmd {
+	question := fmt.Sprintf("Remove all %s from the blocklist? Sonarr might grab them again.", itemCount(int(m.totalRecords())))
+
+	m.state = stateConfirm
+	m.confirm = confirm.New("Clear blocklist", question, m.client.ClearBlocklist(), styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.confirm.Init()
+}
+
+// targetIDs returns the ids of the marked items or the id of the item under the cursor if nothing is marked
+func (m Model) targetIDs() []int32 {
+	if ids := m.selected.Keys(); len(ids) > 0 {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		return ids
+	}
+	if item := m.selectedItem(); item != nil {
+		return []int32{item.ID}
+	}
+	return nil
+}
+
+func (m Model) records() []*sonarrAPI.BlocklistResource {
+	if m.blocklist == nil {
+		return nil
+	}
+	return m.blocklist.Records
+}
+
+func (m Model) selectedItem() *sonarrAPI.BlocklistResource {
+	records := m.records()
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(records) {
+		return nil
+	}
+	return records[cursor]
+}
+
+func (m Model) totalRecords() int32 {
+	if m.blocklist == nil {
+		return 0
+	}
+	return m.blocklist.TotalRecords
+}
+
+func itemCount(count int) string {
+	if count == 1 {
+		return "1 release"
+	}
+	return fmt.Sprintf("%d releases", count)
+}
+
+// updateTable sets the rows and columns of the table and distributes the available height
+func (m *Model) updateTable() {
+	records := m.records()
+	rows := make([]table.Row, 0, len(records))
+	for _, item := range records {
+		checkbox := "⬜"
+		if m.selected[item.ID] {
+			checkbox = "✅"
+		}
+		rows = append(rows, table.Row{
+			checkbox,
+			m.seriesTitle(item),
+			m.episodeNumbers(item),
+			item.SourceTitle,
+			quality(item.Quality),
+			item.Date.Local().Format("02.01.2006 15:04"),
+			item.Indexer,
+			truncate.StringWithTail(item.Message, reasonWidth, common.Ellipsis),
+		})
+	}
+
+	// the page info, the table header and the spacing take 5 lines
+	common.FitTable(&m.table, boxStyle, m.Width, m.Height, detailsHeight+5, rows, []string{"", "Series", "Episode", "Source Title", "Quality", "Date", "Indexer", "Reason"}, 3)
+}
+
+func (m Model) seriesTitle(item *sonarrAPI.BlocklistResource) string {
+	if item.Series != nil {
+		return item.Series.Title
+	}
+	for _, s := range m.client.GetSeries() {
+		if s.ID == item.SeriesID {
+			return s.Title
+		}
+	}
+	return "-"
+}
+
+// episodeNumbers formats the episodes of the release, e.g. S01E01 or S01E01-E03 for multi episode releases
+func (m Model) episodeNumbers(item *sonarrAPI.BlocklistResource) string {
+	episodes := make([]*sonarrAPI.EpisodeResource, 0, len(item.EpisodeIDs))
+	for _, id := range item.EpisodeIDs {
+		if e, ok := m.episodes[id]; ok {
+			episodes = append(episodes, e)
+		}
+	}
+	if len(episodes) == 0 {
+		return "-"
+	}
+	sort.Slice(episodes, func(i, j int) bool {
+		if episodes[i].SeasonNumber != episodes[j].SeasonNumber {
+			return episodes[i].SeasonNumber < episodes[j].SeasonNumber
+		}
+		return episodes[i].EpisodeNumber < episodes[j].EpisodeNumber
+	})
+	first, last := episodes[0], episodes[len(episodes)-1]
+	if len(episodes) == 1 {
+		return fmt.Sprintf("S%02dE%02d", first.SeasonNumber, first.EpisodeNumber)
+	}
+	if first.SeasonNumber != last.SeasonNumber {
+		return fmt.Sprintf("S%02dE%02d-S%02dE%02d", first.SeasonNumber, first.EpisodeNumber, last.SeasonNumber, last.EpisodeNumber)
+	}
+	return fmt.Sprintf("S%02dE%02d-E%02d", first.SeasonNumber, first.EpisodeNumber, last.EpisodeNumber)
+}
+
+func quality(q *sonarrAPI.QualityModel) string {
+	if q == nil || q.Quality == nil {
+		return "Unknown"
+	}
+	return q.Quality.Name
+}
+
+func languages(languages []sonarrAPI.Language) string {
+	if len(languages) == 0 {
+		return "Unknown"
+	}
+	names := make([]string, len(languages))
+	for i, language := range languages {
+		names[i] = language.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// detailsHeight is the height of the details of the selected item
+const detailsHeight = 3
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Bold(true)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(styles.ErrorColor)
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateBlocklist:
+		return m.blocklistView()
+	case stateConfirm:
+		fg := m.confirm.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		// make sure background fills the whole screen
+		bg := m.blocklistView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	}
+	return ":("
+}
+
+func (m Model) blocklistView() string {
+	var s strings.Builder
+
+	info := fmt.Sprintf("Page %d/%d • %s", m.pager.Page, m.pager.TotalPages(), itemCount(int(m.totalRecords())))
+	if len(m.selected) > 0 {
+		info += fmt.Sprintf(" • %d selected", len(m.selected))
+	}
+	s.WriteString(subtleStyle.Render(info))
+	s.WriteString("\n\n")
+
+	if m.totalRecords() == 0 {
+		s.WriteString(subtleStyle.Render("The blocklist is empty"))
+	} else {
+		s.WriteString(m.table.View())
+		s.WriteString("\n\n")
+		s.WriteString(m.detailsView())
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+// detailsView renders the source title, the release details and the full reason of the selected item
+func (m Model) detailsView() string {
+	item := m.selectedItem()
+	if item == nil {
+		return ""
+	}
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	lines := []string{titleStyle.Render(item.SourceTitle)}
+
+	info := []string{
+		fmt.Sprintf("Protocol: %s", item.Protocol),
+		fmt.Sprintf("Languages: %s", languages(item.Languages)),
+		fmt.Sprintf("Quality: %s", quality(item.Quality)),
+	}
+	if item.Indexer != "" {
+		info = append(info, fmt.Sprintf("Indexer: %s", item.Indexer))
+	}
+	lines = append(lines, subtleStyle.Render(strings.Join(info, " • ")))
+
+	if item.Message != "" {
+		lines = append(lines, errorStyle.Render(item.Message))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxHeight(detailsHeight).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTable()
+
+	if m.confirm != nil {
+		m.confirm.SetSize(min(width, 60), height)
+	}
+}
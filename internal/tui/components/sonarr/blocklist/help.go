@@ -0,0 +1,43 @@
+package blocklist
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	NextPage   key.Binding
+	PrevPage   key.Binding
+	Toggle     key.Binding
+	ToggleAll  key.Binding
+	Remove     key.Binding
+	Clear      key.Binding
+	Reload     key.Binding
+	Quit       key.Binding
+	Back       key.Binding
+	Help       key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	NextPage:   key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next page")),
+	PrevPage:   key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "prev page")),
+	Toggle:     key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle")),
+	ToggleAll:  key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "toggle page")),
+	Remove:     key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "remove selected")),
+	Clear:      key.NewBinding(key.WithKeys("ctrl+x"), key.WithHelp("ctrl+x", "clear all")),
+	Reload:     key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
+	Quit:       key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:       key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.NextPage, k.PrevPage},
+		{k.Toggle, k.ToggleAll, k.Remove, k.Clear},
+		{k.Reload, k.Help, k.Back, k.Quit},
+	}
+}
@@ -0,0 +1,464 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/muesli/reflow/truncate"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateCalendar
+)
+
+type view int
+
+const (
+	viewAgenda view = iota + 1
+	viewWeek
+)
+
+type Model struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	state   state
+	view    view
+	spinner common.Spinner
+
+	// start is the local midnight of the monday of the displayed week
+	start       time.Time
+	unmonitored bool
+	episodes    []*sonarrAPI.EpisodeResource
+	queued      map[int32]bool
+	// days holds the indices of the episodes airing on each day of the week
+	days   [7][]int
+	cursor int
+}
+
+func New(client *sonarr.Client, width, height int) *Model {
+	m := Model{
+		client:  client,
+		state:   stateLoading,
+		view:    viewAgenda,
+		spinner: common.NewSpinner(),
+		start:   startOfWeek(time.Now()),
+	}
+
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Title() string {
+	return "Calendar"
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.fetchCalendar(),
+	)
+}
+
+func (m Model) fetchCalendar() tea.Cmd {
+	return m.client.FetchCalendar(m.start, m.start.AddDate(0, 0, 7), m.unmonitored)
+}
+
+// startOfWeek returns the local midnight of the monday of the week t is in
+func startOfWeek(t time.Time) time.Time {
+	t = t.Local()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.TabModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, DefaultKeyMap.Back):
+			m.IsBack = true
+			return m, nil
+
+		case key.Matches(msg, DefaultKeyMap.Quit):
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.state != stateCalendar {
+			break
+		}
+
+		switch {
+		case key.Matches(msg, DefaultKeyMap.CursorUp):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+			return m, nil
+
+		case key.Matches(msg, DefaultKeyMap.CursorDown):
+			if m.cursor < len(m.episodes)-1 {
+				m.cursor++
+			}
+			return m, nil
+
+		case key.Matches(msg, DefaultKeyMap.NextWeek):
+			return m, m.setWeek(m.start.AddDate(0, 0, 7))
+
+		case key.Matches(msg, DefaultKeyMap.PrevWeek):
+			return m, m.setWeek(m.start.AddDate(0, 0, -7))
+
+		case key.Matches(msg, DefaultKeyMap.Today):
+			return m, m.setWeek(startOfWeek(time.Now()))
+
+		case key.Matches(msg, DefaultKeyMap.ToggleView):
+			if m.view == viewAgenda {
+				m.view = viewWeek
+			} else {
+				m.view = viewAgenda
+			}
+			return m, nil
+
+		case key.Matches(msg, DefaultKeyMap.Unmonitored):
+			m.unmonitored = !m.unmonitored
+			return m, m.setWeek(m.start)
+
+		case key.Matches(msg, DefaultKeyMap.Reload):
+			return m, tea.Batch(
+				m.fetchCalendar(),
+				statusbar.NewMessageCmd("Reloading...", statusbar.WithMessageTimeout(2)),
+			)
+		}
+
+	case sonarr.FetchCalendarResult:
+		// ignore results of weeks which aren't displayed anymore
+		if !msg.Start.Equal(m.start) {
+			return m, nil
+		}
+		m.state = stateCalendar
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch calendar")
+		}
+		m.setEpisodes(msg.Episodes, msg.Queued)
+		return m, nil
+	}
+
+	if m.state == stateLoading {
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// setWeek switches to the week starting at start and fetches its episodes
+func (m *Model) setWeek(start time.Time) tea.Cmd {
+	m.start = start
+	m.state = stateLoading
+	return tea.Batch(
+		m.spinner.Tick,
+		m.fetchCalendar(),
+	)
+}
+
+// setEpisodes groups the episodes by the local day they air on and moves the cursor
+// to the next upcoming episode if the current week is displayed
+func (m *Model) setEpisodes(episodes []*sonarrAPI.EpisodeResource, queued map[int32]bool) {
+	m.episodes = episodes
+	m.queued = queued
+	m.days = [7][]int{}
+	m.cursor = 0
+
+	now := time.Now()
+	upcoming := -1
+	for i, episode := range episodes {
+		airDay := startOfDay(episode.AirDateUTC)
+		for day := range m.days {
+			// compare the dates instead of the durations, days aren't 24 hours long during DST changes
+			if airDay.Equal(m.start.AddDate(0, 0, day)) {
+				m.days[day] = append(m.days[day], i)
+				break
+			}
+		}
+		if upcoming < 0 && episode.AirDateUTC.After(now) {
+			upcoming = i
+		}
+	}
+	if upcoming >= 0 && m.start.Equal(startOfWeek(now)) {
+		m.cursor = upcoming
+	}
+}
+
+type episodeStatus int
+
+const (
+	statusUnaired episodeStatus = iota + 1
+	statusMissing
+	statusQueued
+	statusDownloaded
+)
+
+func (m Model) status(episode *sonarrAPI.EpisodeResource) episodeStatus {
+	switch {
+	case episode.HasFile:
+		return statusDownloaded
+	case m.queued[episode.ID]:
+		return statusQueued
+	case episode.AirDateUTC.IsZero() || episode.AirDateUTC.After(time.Now()):
+		return statusUnaired
+	default:
+		return statusMissing
+	}
+}
+
+func (s episodeStatus) String() string {
+	switch s {
+	case statusDownloaded:
+		return "Downloaded"
+	case statusQueued:
+		return "Queued"
+	case statusMissing:
+		return "Missing"
+	default:
+		return "Unaired"
+	}
+}
+
+func (s episodeStatus) style(monitored bool) lipgloss.Style {
+	style := unairedStyle
+	switch s {
+	case statusDownloaded:
+		style = downloadedStyle
+	case statusQueued:
+		style = queuedStyle
+	case statusMissing:
+		style = missingStyle
+	}
+	if !monitored {
+		return style.Copy().Faint(true)
+	}
+	return style
+}
+
+func seriesTitle(episode *sonarrAPI.EpisodeResource) string {
+	if episode.Series != nil {
+		return episode.Series.Title
+	}
+	return episode.SeriesTitle
+}
+
+func episodeNumber(episode *sonarrAPI.EpisodeResource) string {
+	return fmt.Sprintf("S%02dE%02d", episode.SeasonNumber, episode.EpisodeNumber)
+}
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	dayStyle = lipgloss.NewStyle().
+			Bold(true)
+
+	todayStyle = dayStyle.Copy().
+			Foreground(styles.SonarrBlue)
+
+	selectedStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(styles.SonarrBlue)
+
+	columnStyle = lipgloss.NewStyle().
+			Padding(0, 1).
+			Border(lipgloss.NormalBorder(), false, false, false, true).
+			BorderForeground(styles.SubtleColor)
+
+	downloadedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.AdaptiveColor{Light: "#4ECCA3", Dark: "#4ECCA3"})
+
+	queuedStyle = lipgloss.NewStyle().
+			Foreground(styles.PurpleColor)
+
+	missingStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.AdaptiveColor{Light: "#F71735", Dark: "#F71735"})
+
+	unairedStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+)
+
+func (m Model) View() string {
+	if m.state == stateLoading {
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	}
+
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+	// the header and the spacing take 2 lines
+	height := m.Height - boxStyle.GetVerticalFrameSize() - 2
+
+	var s strings.Builder
+	s.WriteString(subtleStyle.Render(m.headerView()))
+	s.WriteString("\n\n")
+
+	switch m.view {
+	case viewAgenda:
+		s.WriteString(m.agendaView(width, height))
+	case viewWeek:
+		s.WriteString(m.weekView(width, height))
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+func (m Model) headerView() string {
+	end := m.start.AddDate(0, 0, 6)
+	header := fmt.Sprintf("Week %s - %s • %d episodes",
+		m.start.Format("02.01.2006"), end.Format("02.01.2006"), len(m.episodes))
+	if m.unmonitored {
+		header += " • including unmonitored"
+	}
+	return header
+}
+
+func (m Model) dayHeader(day int, format string) string {
+	date := m.start.AddDate(0, 0, day)
+	if date.Equal(startOfDay(time.Now())) {
+		return todayStyle.Render(date.Format(format) + " (Today)")
+	}
+	return dayStyle.Render(date.Format(format))
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// agendaView lists the episodes day by day and scrolls to keep the selected episode visible
+func (m Model) agendaView(width, height int) string {
+	if len(m.episodes) == 0 {
+		return subtleStyle.Render("Nothing airs this week")
+	}
+
+	var lines []string
+	var cursorLine int
+	for day, indices := range m.days {
+		if len(indices) == 0 {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, m.dayHeader(day, "Monday, 02.01.2006"))
+		for _, i := range indices {
+			if i == m.cursor {
+				cursorLine = len(lines)
+			}
+			lines = append(lines, m.agendaEntry(m.episodes[i], width, i == m.cursor))
+		}
+	}
+
+	offset := 0
+	if cursorLine >= height {
+		offset = cursorLine - height + 1
+	}
+	lines = lines[offset:]
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) agendaEntry(episode *sonarrAPI.EpisodeResource, width int, selected bool) string {
+	status := m.status(episode)
+
+	// cursor, air time, episode number, status and the spacing between them
+	const fixedWidth = 2 + 5 + 2 + 6 + 2 + 2 + 10 + 2
+	flexWidth := max(width-fixedWidth, 2)
+	seriesWidth := flexWidth * 2 / 5
+	titleWidth := flexWidth - seriesWidth
+
+	cursor := "  "
+	series := truncate.StringWithTail(seriesTitle(episode), uint(seriesWidth), common.Ellipsis)
+	if selected {
+		cursor = selectedStyle.Render("> ")
+		series = selectedStyle.Render(series)
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		cursor,
+		episode.AirDateUTC.Local().Format("15:04"),
+		"  ",
+		lipgloss.NewStyle().Width(seriesWidth).Render(series),
+		"  ",
+		episodeNumber(episode),
+		"  ",
+		lipgloss.NewStyle().Width(titleWidth).Render(truncate.StringWithTail(episode.Title, uint(titleWidth), common.Ellipsis)),
+		"  ",
+		status.style(episode.Monitored).Render(status.String()),
+	)
+}
+
+// weekView renders a column for each day of the week
+func (m Model) weekView(width, height int) string {
+	// the columns are separated by a border
+	columnWidth := max((width-(len(m.days)-1))/len(m.days), 4)
+
+	columns := make([]string, len(m.days))
+	for day, indices := range m.days {
+		style := columnStyle.Copy().Width(columnWidth).Height(height).MaxHeight(height)
+		switch day {
+		case 0:
+			style = style.BorderLeft(false).PaddingLeft(0).Width(columnWidth - 1)
+		case len(m.days) - 1:
+			// the last column takes up the remaining space
+			style = style.Width(width - (len(m.days)-1)*(columnWidth+1))
+		}
+		contentWidth := uint(max(style.GetWidth()-style.GetHorizontalPadding(), 1))
+
+		lines := []string{
+			truncate.StringWithTail(m.dayHeader(day, "Mon 02.01."), contentWidth, common.Ellipsis),
+			"",
+		}
+		for _, i := range indices {
+			episode := m.episodes[i]
+			status := m.status(episode)
+
+			title := fmt.Sprintf("%s %s", episode.AirDateUTC.Local().Format("15:04"), seriesTitle(episode))
+			title = truncate.StringWithTail(title, contentWidth, common.Ellipsis)
+			if i == m.cursor {
+				title = selectedStyle.Render(title)
+			}
+			details := truncate.StringWithTail(fmt.Sprintf("%s %s", episodeNumber(episode), status), contentWidth, common.Ellipsis)
+
+			lines = append(lines, title, status.style(episode.Monitored).Render(details), "")
+		}
+
+		columns[day] = style.Render(strings.Join(lines, "\n"))
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+}
@@ -0,0 +1,41 @@
+package calendar
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMap struct {
+	CursorUp    key.Binding
+	CursorDown  key.Binding
+	NextWeek    key.Binding
+	PrevWeek    key.Binding
+	Today       key.Binding
+	ToggleView  key.Binding
+	Unmonitored key.Binding
+	Quit        key.Binding
+	Back        key.Binding
+	Help        key.Binding
+	Reload      key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	CursorUp:    key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown:  key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	NextWeek:    key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next week")),
+	PrevWeek:    key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "prev week")),
+	Today:       key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "today")),
+	ToggleView:  key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "agenda/week")),
+	Unmonitored: key.NewBinding(key.WithKeys("u"), key.WithHelp("u", "toggle unmonitored")),
+	Quit:        key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:        key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:        key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+	Reload:      key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.NextWeek, k.PrevWeek},
+		{k.Today, k.ToggleView, k.Unmonitored, k.Reload},
+		{k.Help, k.Back, k.Quit},
+	}
+}
@@ -11,8 +11,9 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/jon4hz/submarr/internal/core/sonarr"
 	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/mediainfo"
 	"github.com/jon4hz/submarr/internal/tui/components/sonarr/episode/deleteepisode"
-	"github.com/jon4hz/submarr/internal/tui/components/sonarr/episode/mediainfo"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/releases"
 	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
 	"github.com/jon4hz/submarr/internal/tui/overlay"
 	"github.com/jon4hz/submarr/internal/tui/styles"
@@ -25,6 +26,7 @@ const (
 	stateEpisode state = iota + 1
 	stateDetails
 	stateConfirmDelete
+	stateReleases
 )
 
 type Model struct {
@@ -36,6 +38,7 @@ type Model struct {
 	table         table.Model
 	mediaInfo     common.SubModel
 	confirmDelete common.SubModel
+	releases      common.SubModel
 }
 
 func New(client *sonarr.Client, episode *sonarrAPI.EpisodeResource, width, height int) common.SubModel {
@@ -107,12 +110,17 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 				return m, nil
 			case key.Matches(msg, DefaultKeyMap.Select):
 				m.state = stateDetails
-				m.mediaInfo = mediainfo.New(m.episode, m.Width, m.Height)
+				m.mediaInfo = mediainfo.New(mediainfo.FromSonarr(m.episode.EpisodeFile.MediaInfo), m.Width, m.Height)
 				return m, m.mediaInfo.Init()
 			case key.Matches(msg, DefaultKeyMap.Delete):
 				m.state = stateConfirmDelete
 				m.confirmDelete = deleteepisode.New(m.client, m.episode, m.Width, m.Height)
 				return m, m.confirmDelete.Init()
+			case key.Matches(msg, DefaultKeyMap.InteractiveSearch):
+				m.state = stateReleases
+				title := fmt.Sprintf("%s ❯ S%02dE%02d", m.episode.Series.Title, m.episode.SeasonNumber, m.episode.EpisodeNumber)
+				m.releases = releases.New(m.client, title, m.client.FetchEpisodeReleases(m.episode), m.Width, m.Height+boxStyle.GetVerticalFrameSize())
+				return m, m.releases.Init()
 			}
 
 		case stateDetails:
@@ -168,6 +176,18 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 			return m, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
 		}
 		return m, cmd
+	case stateReleases:
+		var cmd tea.Cmd
+		m.releases, cmd = m.releases.Update(msg)
+		if m.releases.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+		if m.releases.Back() {
+			m.state = stateEpisode
+			return m, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+		}
+		return m, cmd
 	}
 
 	return m, nil
@@ -199,6 +219,8 @@ func (m Model) View() string {
 		return m.episodeDetailsView()
 	case stateConfirmDelete:
 		return m.episodeConfirmDeleteView()
+	case stateReleases:
+		return m.releases.View()
 	}
 	return ":("
 }
@@ -274,6 +296,10 @@ func (m *Model) SetSize(width, height int) {
 	if m.confirmDelete != nil {
 		m.confirmDelete.SetSize(width, height)
 	}
+
+	if m.releases != nil {
+		m.releases.SetSize(width, height+boxStyle.GetVerticalFrameSize())
+	}
 }
 
 func (m *Model) resizeTable(width int) {
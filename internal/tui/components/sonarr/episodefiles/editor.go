@@ -0,0 +1,317 @@
+package episodefiles
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+var dialogStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder(), true).
+	BorderForeground(styles.SonarrBlue).
+	Padding(1, 2)
+
+type editorStep int
+
+const (
+	stepLoading editorStep = iota + 1
+	stepQuality
+	stepLanguages
+)
+
+// keepID is the id of the option to keep the current value
+const keepID = -1
+
+type optionItem struct {
+	id        int32
+	label     string
+	checkable bool
+	checked   bool
+}
+
+func (i optionItem) FilterValue() string { return i.label }
+
+type optionDelegate struct{}
+
+func (d optionDelegate) Height() int { return 1 }
+
+func (d optionDelegate) Spacing() int { return 0 }
+
+var itemStyles = list.NewDefaultItemStyles()
+
+func (d optionDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(optionItem)
+	if !ok {
+		return
+	}
+
+	text := i.label
+	if i.checkable {
+		text = fmt.Sprintf("⬜ %s", i.label)
+		if i.checked {
+			text = fmt.Sprintf("✅ %s", i.label)
+		}
+	}
+
+	if index == m.Index() {
+		fmt.Fprint(w, itemStyles.SelectedTitle.Render(text))
+		return
+	}
+	fmt.Fprint(w, itemStyles.NormalTitle.Render(text))
+}
+
+func (d optionDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+// editor lets the user change the quality and the languages of episode files
+type editor struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	files   []*sonarrAPI.EpisodeFileResource
+	step    editorStep
+	list    list.Model
+	spinner common.Spinner
+
+	qualities []*sonarrAPI.Quality
+	languages []*sonarrAPI.Language
+	// quality is nil if the current quality is kept
+	quality *sonarrAPI.Quality
+}
+
+func newEditor(client *sonarr.Client, files []*sonarrAPI.EpisodeFileResource, width, height int) *editor {
+	m := editor{
+		client:  client,
+		files:   files,
+		step:    stepLoading,
+		list:    sonarr_list.New("", nil, optionDelegate{}, width, height),
+		spinner: common.NewSpinner(),
+	}
+	m.list.SetShowStatusBar(false)
+	m.list.FilterInput.Prompt = "Search: "
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m editor) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(EditorKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.client.FetchEpisodeFileOptions(),
+	)
+}
+
+func (m *editor) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.list.SettingFilter() {
+			break
+		}
+		switch {
+		case key.Matches(msg, EditorKeyMap.Quit):
+			m.IsQuit = true
+			return m, nil
+
+		case key.Matches(msg, EditorKeyMap.Back):
+			if m.list.IsFiltered() {
+				break
+			}
+			if m.step == stepLanguages {
+				m.showQualities()
+				return m, nil
+			}
+			m.IsBack = true
+			return m, nil
+
+		case key.Matches(msg, EditorKeyMap.Toggle):
+			if m.step != stepLanguages {
+				return m, nil
+			}
+			item, ok := m.list.SelectedItem().(optionItem)
+			if !ok {
+				return m, nil
+			}
+			item.checked = !item.checked
+			// the index of the selected item is relative to the visible items
+			for i, listItem := range m.list.Items() {
+				if listItem.(optionItem).id == item.id {
+					m.list.SetItem(i, item)
+					break
+				}
+			}
+			return m, nil
+
+		case key.Matches(msg, EditorKeyMap.Select):
+			return m, m.selectItem()
+		}
+
+	case sonarr.FetchEpisodeFileOptionsResult:
+		if m.step != stepLoading {
+			return m, nil
+		}
+		if msg.Error != nil {
+			m.IsBack = true
+			return m, statusbar.NewErrCmd("Failed to fetch qualities and languages")
+		}
+		m.qualities = msg.Qualities
+		m.languages = msg.Languages
+		m.showQualities()
+		return m, nil
+	}
+
+	if m.step == stepLoading {
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+// selectItem moves on to the languages or saves the changes
+func (m *editor) selectItem() tea.Cmd {
+	switch m.step {
+	case stepQuality:
+		item, ok := m.list.SelectedItem().(optionItem)
+		if !ok {
+			return nil
+		}
+		m.quality = nil
+		for _, quality := range m.qualities {
+			// selecting the current quality is not a change
+			if quality.ID == item.id && !m.hasQuality(quality) {
+				m.quality = quality
+				break
+			}
+		}
+		m.showLanguages()
+		return nil
+
+	case stepLanguages:
+		languages := make([]sonarrAPI.Language, 0)
+		for _, listItem := range m.list.Items() {
+			if !listItem.(optionItem).checked {
+				continue
+			}
+			for _, language := range m.languages {
+				if language.ID == listItem.(optionItem).id {
+					languages = append(languages, *language)
+				}
+			}
+		}
+		if m.hasLanguages(languages) {
+			languages = nil
+		}
+		if m.quality == nil && len(languages) == 0 {
+			return statusbar.NewErrCmd("Nothing to change")
+		}
+
+		m.IsBack = true
+		return tea.Batch(
+			m.client.EditEpisodeFiles(m.files, m.quality, languages),
+			statusbar.NewMessageCmd("Updating files...", statusbar.WithMessageTimeout(2)),
+		)
+	}
+
+	return nil
+}
+
+func (m *editor) showQualities() {
+	m.step = stepQuality
+	m.list.ResetFilter()
+	m.list.Title = "Quality"
+
+	items := make([]list.Item, 0, len(m.qualities)+1)
+	items = append(items, optionItem{id: keepID, label: "Keep current quality"})
+	var cursor int
+	for _, quality := range m.qualities {
+		items = append(items, optionItem{id: quality.ID, label: quality.Name})
+		if m.hasQuality(quality) {
+			cursor = len(items) - 1
+		}
+	}
+	m.list.SetItems(items)
+	m.list.Select(cursor)
+}
+
+func (m *editor) showLanguages() {
+	m.step = stepLanguages
+	m.list.ResetFilter()
+	m.list.Title = "Languages"
+
+	current := make(map[int32]bool)
+	for _, language := range m.current().Languages {
+		current[language.ID] = true
+	}
+
+	items := make([]list.Item, len(m.languages))
+	for i, language := range m.languages {
+		items[i] = optionItem{
+			id:        language.ID,
+			label:     language.Name,
+			checkable: true,
+			checked:   current[language.ID],
+		}
+	}
+	m.list.SetItems(items)
+	m.list.Select(0)
+}
+
+// current returns the file whose values are preselected.
+// If multiple files are edited, nothing is preselected.
+func (m editor) current() *sonarrAPI.EpisodeFileResource {
+	if len(m.files) == 1 {
+		return m.files[0]
+	}
+	return &sonarrAPI.EpisodeFileResource{}
+}
+
+func (m editor) hasQuality(quality *sonarrAPI.Quality) bool {
+	current := m.current().Quality
+	return current != nil && current.Quality != nil && current.Quality.ID == quality.ID
+}
+
+func (m editor) hasLanguages(languages []sonarrAPI.Language) bool {
+	current := m.current().Languages
+	if len(current) != len(languages) {
+		return false
+	}
+	ids := make(map[int32]bool, len(current))
+	for _, language := range current {
+		ids[language.ID] = true
+	}
+	for _, language := range languages {
+		if !ids[language.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *editor) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+	m.list.SetSize(
+		width-dialogStyle.GetHorizontalFrameSize(),
+		height-dialogStyle.GetVerticalFrameSize(),
+	)
+}
+
+func (m editor) View() string {
+	if m.step == stepLoading {
+		return dialogStyle.Render(m.spinner.View() + " Loading qualities...")
+	}
+	return dialogStyle.Render(m.list.View())
+}
@@ -0,0 +1,453 @@
+package episodefiles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/confirm"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateFiles
+	stateEditor
+	stateConfirm
+)
+
+type Model struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	state   state
+	spinner common.Spinner
+	table   table.Model
+	editor  common.SubModel
+	confirm common.SubModel
+
+	files []*sonarrAPI.EpisodeFileResource
+	// selected contains the ids of the marked episode files
+	selected common.Selection[int32]
+}
+
+// New returns the episode files of the selected serie
+func New(client *sonarr.Client, width, height int) common.SubModel {
+	m := Model{
+		client:   client,
+		state:    stateLoading,
+		spinner:  common.NewSpinner(),
+		table:    common.NewTable(),
+		selected: make(common.Selection[int32]),
+	}
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.client.FetchEpisodeFiles(),
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+
+		case stateFiles:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				return m, tea.Batch(
+					m.client.FetchEpisodeFiles(),
+					statusbar.NewMessageCmd("Reloading...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.Rescan):
+				return m, tea.Batch(
+					m.client.RescanSeries(),
+					statusbar.NewMessageCmd("Rescanning series...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.Toggle):
+				if file := m.selectedFile(); file != nil {
+					m.selected.Toggle(file.ID)
+					m.updateTable()
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.ToggleAll):
+				m.selected.ToggleAll(m.fileIDs())
+				m.updateTable()
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Edit):
+				if files := m.targetFiles(); len(files) > 0 {
+					return m, m.editFiles(files)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Delete):
+				if files := m.targetFiles(); len(files) > 0 {
+					return m, m.confirmDelete(files)
+				}
+				return m, nil
+			}
+		}
+
+	case sonarr.FetchEpisodeFilesResult:
+		if m.state == stateLoading {
+			m.state = stateFiles
+		}
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch episode files")
+		}
+		m.files = msg.Files
+		// forget the marks of files which don't exist anymore
+		m.selected.Retain(m.fileIDs())
+		m.updateTable()
+		return m, nil
+
+	case sonarr.LiveUpdateMsg:
+		if m.state != stateLoading && msg.AffectsSeries(m.client.GetSerie().ID, sonarrAPI.SignalREventEpisodeFile) {
+			return m, m.client.FetchEpisodeFiles()
+		}
+
+	case sonarr.DeleteEpisodeFilesResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(fmt.Sprintf("Failed to delete %s", fileCount(msg.Count)))
+		}
+		return m, tea.Batch(
+			statusbar.NewMessageCmd(fmt.Sprintf("Deleted %s", fileCount(msg.Count))),
+			m.client.FetchEpisodeFiles(),
+		)
+
+	case sonarr.EditEpisodeFilesResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(fmt.Sprintf("Failed to update %s", fileCount(msg.Count)))
+		}
+		return m, tea.Batch(
+			statusbar.NewMessageCmd(fmt.Sprintf("Updated %s", fileCount(msg.Count))),
+			m.client.FetchEpisodeFiles(),
+		)
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateFiles:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+
+	case stateEditor:
+		var cmd tea.Cmd
+		m.editor, cmd = m.editor.Update(msg)
+
+		if m.editor.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.editor.Back() {
+			m.state = stateFiles
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+
+	case stateConfirm:
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+
+		if m.confirm.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.confirm.Back() {
+			m.state = stateFiles
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m *Model) editFiles(files []*sonarrAPI.EpisodeFileResource) tea.Cmd {
+	m.state = stateEditor
+	m.editor = newEditor(m.client, files, min(m.Width, 60), m.Height)
+	return m.editor.Init()
+}
+
+func (m *Model) confirmDelete(files []*sonarrAPI.EpisodeFileResource) tea.Cmd {
+	question := fmt.Sprintf("Delete %d files from the disk?", len(files))
+	if len(files) == 1 {
+		question = fmt.Sprintf("Delete %q from the disk?", files[0].RelativePath)
+	}
+
+	ids := make([]int32, len(files))
+	for i, file := range files {
+		ids[i] = file.ID
+	}
+
+	m.state = stateConfirm
+	m.confirm = confirm.New("Delete episode files", question, m.client.DeleteEpisodeFiles(ids), styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.confirm.Init()
+}
+
+// targetFiles returns the marked files or the file under the cursor if nothing is marked
+func (m Model) targetFiles() []*sonarrAPI.EpisodeFileResource {
+	if marked := m.markedFiles(); len(marked) > 0 {
+		return marked
+	}
+	if file := m.selectedFile(); file != nil {
+		return []*sonarrAPI.EpisodeFileResource{file}
+	}
+	return nil
+}
+
+func (m Model) fileIDs() []int32 {
+	ids := make([]int32, len(m.files))
+	for i, file := range m.files {
+		ids[i] = file.ID
+	}
+	return ids
+}
+
+func (m Model) markedFiles() []*sonarrAPI.EpisodeFileResource {
+	marked := make([]*sonarrAPI.EpisodeFileResource, 0)
+	for _, file := range m.files {
+		if m.selected[file.ID] {
+			marked = append(marked, file)
+		}
+	}
+	return marked
+}
+
+func (m Model) selectedFile() *sonarrAPI.EpisodeFileResource {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.files) {
+		return nil
+	}
+	return m.files[cursor]
+}
+
+func fileCount(count int) string {
+	if count == 1 {
+		return "1 file"
+	}
+	return fmt.Sprintf("%d files", count)
+}
+
+// updateTable sets the rows and columns of the table and distributes the available height
+func (m *Model) updateTable() {
+	rows := make([]table.Row, 0, len(m.files))
+	for _, file := range m.files {
+		checkbox := "⬜"
+		if m.selected[file.ID] {
+			checkbox = "✅"
+		}
+		rows = append(rows, table.Row{
+			checkbox,
+			file.RelativePath,
+			fmt.Sprint(file.SeasonNumber),
+			quality(file.Quality),
+			languages(file.Languages),
+			humanize.IBytes(uint64(file.Size)),
+			dateAdded(file),
+		})
+	}
+
+	// the title, the table header and the spacing take 5 lines
+	common.FitTable(&m.table, boxStyle, m.Width, m.Height, detailsHeight+5, rows, []string{"", "Path", "Season", "Quality", "Languages", "Size", "Added"}, 1)
+}
+
+func dateAdded(file *sonarrAPI.EpisodeFileResource) string {
+	if file.DateAdded.IsZero() {
+		return "-"
+	}
+	return file.DateAdded.Local().Format("2006-01-02")
+}
+
+func quality(q *sonarrAPI.QualityModel) string {
+	if q == nil || q.Quality == nil {
+		return "Unknown"
+	}
+	if q.Revision != nil && q.Revision.Version > 1 {
+		return fmt.Sprintf("%s Proper", q.Quality.Name)
+	}
+	return q.Quality.Name
+}
+
+func languages(languages []sonarrAPI.Language) string {
+	if len(languages) == 0 {
+		return "Unknown"
+	}
+	names := make([]string, len(languages))
+	for i, language := range languages {
+		names[i] = language.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// detailsHeight is the height of the details of the selected file
+const detailsHeight = 3
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Padding(0, 1).
+			Background(styles.PurpleColor)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	warningStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FFD700"))
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateFiles:
+		return m.filesView()
+	case stateEditor:
+		fg := m.editor.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		// make sure background fills the whole screen
+		bg := m.filesView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	case stateConfirm:
+		fg := m.confirm.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		bg := m.filesView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	}
+	return ":("
+}
+
+func (m Model) filesView() string {
+	var s strings.Builder
+
+	var size int64
+	for _, file := range m.files {
+		size += file.Size
+	}
+	s.WriteString(titleStyle.Render(fmt.Sprintf("%s ❯ Files", m.client.GetSerie().Title)))
+	s.WriteString(subtleStyle.Render(fmt.Sprintf(" %s • %s", fileCount(len(m.files)), humanize.IBytes(uint64(size)))))
+	if marked := len(m.markedFiles()); marked > 0 {
+		s.WriteString(subtleStyle.Render(fmt.Sprintf(" • %d selected", marked)))
+	}
+	s.WriteString("\n\n")
+
+	if len(m.files) == 0 {
+		s.WriteString(subtleStyle.Render("No files on disk"))
+	} else {
+		s.WriteString(m.table.View())
+		s.WriteString("\n\n")
+		s.WriteString(m.detailsView())
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+// detailsView renders the full path and the release information of the selected file
+func (m Model) detailsView() string {
+	file := m.selectedFile()
+	if file == nil {
+		return ""
+	}
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	lines := []string{subtleStyle.Render(file.Path)}
+
+	var release []string
+	if file.ReleaseGroup != "" {
+		release = append(release, fmt.Sprintf("Release group: %s", file.ReleaseGroup))
+	}
+	if file.SceneName != "" {
+		release = append(release, fmt.Sprintf("Scene name: %s", file.SceneName))
+	}
+	if len(release) > 0 {
+		lines = append(lines, subtleStyle.Render(strings.Join(release, " • ")))
+	}
+	if file.QualityCutoffNotMet {
+		lines = append(lines, warningStyle.Render("Quality cutoff not met"))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxHeight(detailsHeight).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTable()
+
+	if m.editor != nil {
+		m.editor.SetSize(min(width, 60), height)
+	}
+
+	if m.confirm != nil {
+		m.confirm.SetSize(min(width, 60), height)
+	}
+}
@@ -0,0 +1,69 @@
+package episodefiles
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	Toggle     key.Binding
+	ToggleAll  key.Binding
+	Edit       key.Binding
+	Delete     key.Binding
+	Rescan     key.Binding
+	Reload     key.Binding
+	Quit       key.Binding
+	Back       key.Binding
+	Help       key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Toggle:     key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle")),
+	ToggleAll:  key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "toggle all")),
+	Edit:       key.NewBinding(key.WithKeys("e", "enter"), key.WithHelp("e", "edit quality & languages")),
+	Delete:     key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "delete selected")),
+	Rescan:     key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "rescan series")),
+	Reload:     key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
+	Quit:       key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:       key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.Toggle, k.ToggleAll},
+		{k.Edit, k.Delete, k.Rescan, k.Reload},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
+type editorKeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	Filter     key.Binding
+	Toggle     key.Binding
+	Select     key.Binding
+	Back       key.Binding
+	Quit       key.Binding
+}
+
+var EditorKeyMap = editorKeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Filter:     key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+	Toggle:     key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle language")),
+	Select:     key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Quit:       key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+}
+
+func (k editorKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.Filter},
+		{k.Toggle, k.Select},
+		{k.Back, k.Quit},
+	}
+}
@@ -16,6 +16,7 @@ type KeyMap struct {
 	Reload     key.Binding
 	Filter     key.Binding
 	AddNew     key.Binding
+	Tasks      key.Binding
 }
 
 var DefaultKeyMap = KeyMap{
@@ -30,6 +31,7 @@ var DefaultKeyMap = KeyMap{
 	Reload:     key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload list")),
 	Filter:     key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
 	AddNew:     key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "add new series")),
+	Tasks:      key.NewBinding(key.WithKeys("ctrl+t"), key.WithHelp("ctrl+t", "running tasks")),
 }
 
 func (k KeyMap) FullHelp() [][]key.Binding {
@@ -37,6 +39,6 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 		{k.CursorUp, k.CursorDown, k.NextPage, k.PrevPage},
 		{k.Filter, k.Select, k.Reload},
 		{k.Help, k.Back, k.Quit},
-		{k.AddNew},
+		{k.AddNew, k.Tasks},
 	}
 }
@@ -0,0 +1,266 @@
+package history
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+// dateFormat is the format of the dates entered in the date range filter
+const dateFormat = "02.01.2006"
+
+var dialogStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder(), true).
+	BorderForeground(styles.SonarrBlue).
+	Padding(1, 2)
+
+// selectSeriesMsg is sent when a series was picked, a nil series removes the filter
+type selectSeriesMsg struct {
+	series *sonarrAPI.SeriesResource
+}
+
+type seriesItem struct {
+	series *sonarrAPI.SeriesResource
+}
+
+func (i seriesItem) FilterValue() string {
+	if i.series == nil {
+		return "All series"
+	}
+	return i.series.Title
+}
+
+type seriesDelegate struct{}
+
+func (d seriesDelegate) Height() int { return 1 }
+
+func (d seriesDelegate) Spacing() int { return 0 }
+
+var itemStyles = list.NewDefaultItemStyles()
+
+func (d seriesDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(seriesItem)
+	if !ok {
+		return
+	}
+	if index == m.Index() {
+		fmt.Fprint(w, itemStyles.SelectedTitle.Render(i.FilterValue()))
+		return
+	}
+	fmt.Fprint(w, itemStyles.NormalTitle.Render(i.FilterValue()))
+}
+
+func (d seriesDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+// seriesPicker lets the user choose the series to filter the history by
+type seriesPicker struct {
+	common.EmbedableModel
+
+	list list.Model
+}
+
+func newSeriesPicker(series []*sonarrAPI.SeriesResource, width, height int) *seriesPicker {
+	items := make([]list.Item, 0, len(series)+1)
+	items = append(items, seriesItem{})
+	for _, s := range series {
+		items = append(items, seriesItem{series: s})
+	}
+
+	m := seriesPicker{
+		list: sonarr_list.New("Filter by series", items, seriesDelegate{}, width, height),
+	}
+	m.list.SetShowStatusBar(false)
+	m.list.FilterInput.Prompt = "Search: "
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m seriesPicker) Init() tea.Cmd {
+	return statusbar.NewHelpCmd(SeriesKeyMap.FullHelp())
+}
+
+func (m *seriesPicker) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && !m.list.SettingFilter() {
+		switch {
+		case key.Matches(msg, SeriesKeyMap.Quit):
+			m.IsQuit = true
+			return m, nil
+
+		case key.Matches(msg, SeriesKeyMap.Back):
+			if !m.list.IsFiltered() {
+				m.IsBack = true
+				return m, nil
+			}
+
+		case key.Matches(msg, SeriesKeyMap.Select):
+			item, ok := m.list.SelectedItem().(seriesItem)
+			if !ok {
+				return m, nil
+			}
+			m.IsBack = true
+			return m, func() tea.Msg { return selectSeriesMsg{series: item.series} }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m *seriesPicker) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+	m.list.SetSize(
+		width-dialogStyle.GetHorizontalFrameSize(),
+		height-dialogStyle.GetVerticalFrameSize(),
+	)
+}
+
+func (m seriesPicker) View() string {
+	return dialogStyle.Render(m.list.View())
+}
+
+// selectDateRangeMsg is sent when a date range was entered, zero values are unbounded
+type selectDateRangeMsg struct {
+	from time.Time
+	// to is exclusive, so it's the day after the entered date
+	to time.Time
+}
+
+// dateRange lets the user enter the date range to filter the history by
+type dateRange struct {
+	common.EmbedableModel
+
+	inputs  []textinput.Model
+	focused int
+}
+
+func newDateRange(from, to time.Time, width, height int) *dateRange {
+	m := dateRange{
+		inputs: make([]textinput.Model, 2),
+	}
+	for i := range m.inputs {
+		input := textinput.New()
+		input.Placeholder = "dd.mm.yyyy"
+		input.CharLimit = len(dateFormat)
+		input.Cursor.Style = lipgloss.NewStyle().Foreground(styles.SonarrBlue)
+		m.inputs[i] = input
+	}
+	m.inputs[0].Prompt = "From: "
+	m.inputs[1].Prompt = "To:   "
+	if !from.IsZero() {
+		m.inputs[0].SetValue(from.Format(dateFormat))
+	}
+	if !to.IsZero() {
+		m.inputs[1].SetValue(to.AddDate(0, 0, -1).Format(dateFormat))
+	}
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m *dateRange) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DateRangeKeyMap.FullHelp()),
+		m.inputs[m.focused].Focus(),
+	)
+}
+
+func (m *dateRange) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, DateRangeKeyMap.Quit):
+			m.IsQuit = true
+			return m, nil
+
+		case key.Matches(msg, DateRangeKeyMap.Back):
+			m.IsBack = true
+			return m, nil
+
+		case key.Matches(msg, DateRangeKeyMap.Next):
+			return m, m.focus((m.focused + 1) % len(m.inputs))
+
+		case key.Matches(msg, DateRangeKeyMap.Prev):
+			return m, m.focus((m.focused - 1 + len(m.inputs)) % len(m.inputs))
+
+		case key.Matches(msg, DateRangeKeyMap.Apply):
+			return m, m.apply()
+		}
+	}
+
+	var cmd tea.Cmd
+	m.inputs[m.focused], cmd = m.inputs[m.focused].Update(msg)
+	return m, cmd
+}
+
+func (m *dateRange) focus(i int) tea.Cmd {
+	m.inputs[m.focused].Blur()
+	m.focused = i
+	return m.inputs[m.focused].Focus()
+}
+
+// apply validates the entered dates and sends them to the history
+func (m *dateRange) apply() tea.Cmd {
+	from, err := parseDate(m.inputs[0].Value())
+	if err != nil {
+		return statusbar.NewErrCmd("Invalid start date, expected dd.mm.yyyy")
+	}
+	to, err := parseDate(m.inputs[1].Value())
+	if err != nil {
+		return statusbar.NewErrCmd("Invalid end date, expected dd.mm.yyyy")
+	}
+	if !to.IsZero() {
+		to = to.AddDate(0, 0, 1)
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return statusbar.NewErrCmd("The start date must be before the end date")
+	}
+
+	m.IsBack = true
+	return func() tea.Msg { return selectDateRangeMsg{from: from, to: to} }
+}
+
+// parseDate parses a date in the local timezone, an empty value results in a zero time
+func parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.ParseInLocation(dateFormat, value, time.Local)
+}
+
+func (m *dateRange) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+	for i := range m.inputs {
+		m.inputs[i].Width = len(dateFormat) + 1
+	}
+}
+
+func (m dateRange) View() string {
+	var s strings.Builder
+	s.WriteString(lipgloss.NewStyle().Bold(true).Render("Filter by date"))
+	s.WriteString("\n\n")
+	for i, input := range m.inputs {
+		s.WriteString(input.View())
+		if i < len(m.inputs)-1 {
+			s.WriteString("\n")
+		}
+	}
+	s.WriteString("\n\n")
+	s.WriteString(lipgloss.NewStyle().Foreground(styles.SubtleColor).Render("Leave a field empty for no limit"))
+	return dialogStyle.Render(s.String())
+}
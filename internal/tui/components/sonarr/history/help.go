@@ -0,0 +1,94 @@
+package history
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMap struct {
+	CursorUp     key.Binding
+	CursorDown   key.Binding
+	NextPage     key.Binding
+	PrevPage     key.Binding
+	EventType    key.Binding
+	Series       key.Binding
+	DateRange    key.Binding
+	ClearFilters key.Binding
+	MarkFailed   key.Binding
+	Quit         key.Binding
+	Back         key.Binding
+	Help         key.Binding
+	Reload       key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	CursorUp:     key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown:   key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	NextPage:     key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next page")),
+	PrevPage:     key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "prev page")),
+	EventType:    key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "event type")),
+	Series:       key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "series")),
+	DateRange:    key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "date range")),
+	ClearFilters: key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "clear filters")),
+	MarkFailed:   key.NewBinding(key.WithKeys("ctrl+f"), key.WithHelp("ctrl+f", "mark as failed")),
+	Quit:         key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:         key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:         key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+	Reload:       key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.NextPage, k.PrevPage},
+		{k.EventType, k.Series, k.DateRange, k.ClearFilters},
+		{k.MarkFailed, k.Reload},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
+type seriesKeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	Filter     key.Binding
+	Select     key.Binding
+	Back       key.Binding
+	Quit       key.Binding
+}
+
+var SeriesKeyMap = seriesKeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Filter:     key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+	Select:     key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	Quit:       key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+}
+
+func (k seriesKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.Filter},
+		{k.Select, k.Back, k.Quit},
+	}
+}
+
+type dateRangeKeyMap struct {
+	Next  key.Binding
+	Prev  key.Binding
+	Apply key.Binding
+	Back  key.Binding
+	Quit  key.Binding
+}
+
+var DateRangeKeyMap = dateRangeKeyMap{
+	Next:  key.NewBinding(key.WithKeys("tab", "down"), key.WithHelp("tab", "next field")),
+	Prev:  key.NewBinding(key.WithKeys("shift+tab", "up"), key.WithHelp("shift+tab", "prev field")),
+	Apply: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "apply")),
+	Back:  key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	Quit:  key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+}
+
+func (k dateRangeKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Next, k.Prev},
+		{k.Apply, k.Back, k.Quit},
+	}
+}
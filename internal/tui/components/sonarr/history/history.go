@@ -0,0 +1,490 @@
+package history
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/confirm"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateHistory
+	stateConfirm
+	stateSeries
+	stateDateRange
+)
+
+// eventTypes are the event types the history can be filtered by, an empty event type includes all events
+var eventTypes = []sonarrAPI.EpisodeHistoryEventType{
+	"",
+	sonarrAPI.EpisodeHistoryEventTypeGrabbed,
+	sonarrAPI.EpisodeHistoryEventTypeDownloadFolderImported,
+	sonarrAPI.EpisodeHistoryEventTypeDownloadFailed,
+	sonarrAPI.EpisodeHistoryEventTypeEpisodeFileDeleted,
+	sonarrAPI.EpisodeHistoryEventTypeEpisodeFileRenamed,
+}
+
+type Model struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	state   state
+	spinner common.Spinner
+	table   table.Model
+	// dialog is the confirm dialog or one of the filter dialogs
+	dialog common.SubModel
+
+	history *sonarrAPI.HistoryResourcePagingResource
+	pager   common.Pager
+	filter  sonarr.HistoryFilter
+	// seriesTitle is the title of the series the history is filtered by
+	seriesTitle string
+}
+
+func New(client *sonarr.Client, width, height int) *Model {
+	m := Model{
+		client:  client,
+		state:   stateLoading,
+		spinner: common.NewSpinner(),
+		table:   common.NewTable(),
+		pager:   common.NewPager(sonarr.HistoryPageSize),
+	}
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Title() string {
+	return "History"
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.client.FetchHistory(m.filter, m.pager.Page),
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.TabModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+
+		case stateHistory:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				return m, tea.Batch(
+					m.client.FetchHistory(m.filter, m.pager.Page),
+					statusbar.NewMessageCmd("Reloading...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.NextPage):
+				if m.pager.NextPage() {
+					return m, m.client.FetchHistory(m.filter, m.pager.Page)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.PrevPage):
+				if m.pager.PrevPage() {
+					return m, m.client.FetchHistory(m.filter, m.pager.Page)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.EventType):
+				m.filter.EventType = nextEventType(m.filter.EventType)
+				return m, m.applyFilter()
+
+			case key.Matches(msg, DefaultKeyMap.Series):
+				m.state = stateSeries
+				m.dialog = newSeriesPicker(m.client.GetSeries(), min(m.Width, 60), m.Height)
+				return m, m.dialog.Init()
+
+			case key.Matches(msg, DefaultKeyMap.DateRange):
+				m.state = stateDateRange
+				m.dialog = newDateRange(m.filter.From, m.filter.To, m.Width, m.Height)
+				return m, m.dialog.Init()
+
+			case key.Matches(msg, DefaultKeyMap.ClearFilters):
+				m.filter = sonarr.HistoryFilter{}
+				m.seriesTitle = ""
+				return m, m.applyFilter()
+
+			case key.Matches(msg, DefaultKeyMap.MarkFailed):
+				if record := m.selectedRecord(); record != nil {
+					return m, m.confirmMarkFailed(record)
+				}
+				return m, nil
+			}
+		}
+
+	case sonarr.FetchHistoryResult:
+		if m.state == stateLoading {
+			m.state = stateHistory
+		}
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch history")
+		}
+		m.history = msg.History
+		// the page might not exist anymore, if records were removed in the meantime
+		if m.history != nil && m.pager.SetTotal(int(m.history.TotalRecords)) {
+			return m, m.client.FetchHistory(m.filter, m.pager.Page)
+		}
+		m.updateTable()
+		return m, nil
+
+	case sonarr.MarkHistoryFailedResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(fmt.Sprintf("Failed to mark %s as failed: %s", msg.Title, msg.Error))
+		}
+		return m, tea.Batch(
+			statusbar.NewMessageCmd(fmt.Sprintf("Marked %s as failed", msg.Title)),
+			m.client.FetchHistory(m.filter, m.pager.Page),
+		)
+
+	case selectSeriesMsg:
+		m.filter.SeriesID = 0
+		m.seriesTitle = ""
+		if msg.series != nil {
+			m.filter.SeriesID = msg.series.ID
+			m.seriesTitle = msg.series.Title
+		}
+		return m, m.applyFilter()
+
+	case selectDateRangeMsg:
+		m.filter.From = msg.from
+		m.filter.To = msg.to
+		return m, m.applyFilter()
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateHistory:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+
+	case stateConfirm, stateSeries, stateDateRange:
+		var cmd tea.Cmd
+		m.dialog, cmd = m.dialog.Update(msg)
+
+		if m.dialog.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.dialog.Back() {
+			m.state = stateHistory
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// applyFilter fetches the first page of the history using the current filter
+func (m *Model) applyFilter() tea.Cmd {
+	m.pager.Page = 1
+	m.table.SetCursor(0)
+	return m.client.FetchHistory(m.filter, m.pager.Page)
+}
+
+func nextEventType(current sonarrAPI.EpisodeHistoryEventType) sonarrAPI.EpisodeHistoryEventType {
+	for i, eventType := range eventTypes {
+		if eventType == current {
+			return eventTypes[(i+1)%len(eventTypes)]
+		}
+	}
+	return eventTypes[0]
+}
+
+func (m *Model) confirmMarkFailed(record *sonarrAPI.HistoryResource) tea.Cmd {
+	if record.EventType != sonarrAPI.EpisodeHistoryEventTypeGrabbed {
+		return statusbar.NewErrCmd("Only grabbed releases can be marked as failed")
+	}
+	question := fmt.Sprintf("Mark %q as failed? Sonarr will blocklist the release and search for another one.", record.SourceTitle)
+
+	m.state = stateConfirm
+	m.dialog = confirm.New("Mark as failed", question, m.client.MarkHistoryFailed(record), styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.dialog.Init()
+}
+
+func (m Model) records() []*sonarrAPI.HistoryResource {
+	if m.history == nil {
+		return nil
+	}
+	return m.history.Records
+}
+
+func (m Model) selectedRecord() *sonarrAPI.HistoryResource {
+	records := m.records()
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(records) {
+		return nil
+	}
+	return records[cursor]
+}
+
+// updateTable sets the rows and columns of the table and distributes the available height
+func (m *Model) updateTable() {
+	records := m.records()
+	rows := make([]table.Row, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, table.Row{
+			EventType(record.EventType),
+			seriesTitle(record),
+			episodeNumber(record),
+			record.SourceTitle,
+			quality(record.Quality),
+			record.Date.Local().Format("02.01.2006 15:04"),
+		})
+	}
+
+	// the page info, the filters, the table header and the spacing take 6 lines
+	common.FitTable(&m.table, boxStyle, m.Width, m.Height, detailsHeight+6, rows, []string{"Event", "Series", "Episode", "Source Title", "Quality", "Date"}, 3)
+}
+
+// EventType returns a human readable history event type
+func EventType(eventType sonarrAPI.EpisodeHistoryEventType) string {
+	switch eventType {
+	case "":
+		return "All"
+	case sonarrAPI.EpisodeHistoryEventTypeGrabbed:
+		return "Grabbed"
+	case sonarrAPI.EpisodeHistoryEventTypeDownloadFolderImported:
+		return "Imported"
+	case sonarrAPI.EpisodeHistoryEventTypeDownloadFailed:
+		return "Failed"
+	case sonarrAPI.EpisodeHistoryEventTypeEpisodeFileDeleted:
+		return "Deleted"
+	case sonarrAPI.EpisodeHistoryEventTypeSeriesFolderImported:
+		return "Folder Imported"
+	case sonarrAPI.EpisodeHistoryEventTypeEpisodeFileRenamed:
+		return "Renamed"
+	case sonarrAPI.EpisodeHistoryEventTypeDownloadIgnored:
+		return "Ignored"
+	default:
+		return "Unknown"
+	}
+}
+
+func seriesTitle(record *sonarrAPI.HistoryResource) string {
+	if record.Series == nil {
+		return "-"
+	}
+	return record.Series.Title
+}
+
+func episodeNumber(record *sonarrAPI.HistoryResource) string {
+	if record.Episode == nil {
+		return "-"
+	}
+	return fmt.Sprintf("S%02dE%02d", record.Episode.SeasonNumber, record.Episode.EpisodeNumber)
+}
+
+func quality(q *sonarrAPI.QualityModel) string {
+	if q == nil || q.Quality == nil {
+		return "Unknown"
+	}
+	return q.Quality.Name
+}
+
+// detailsHeight is the height of the details of the selected record
+const detailsHeight = 4
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Bold(true)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(styles.ErrorColor)
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateHistory:
+		return m.historyView()
+	case stateConfirm, stateSeries, stateDateRange:
+		fg := m.dialog.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		// make sure background fills the whole screen
+		bg := m.historyView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	}
+	return ":("
+}
+
+func (m Model) historyView() string {
+	var s strings.Builder
+
+	var total int32
+	if m.history != nil {
+		total = m.history.TotalRecords
+	}
+	s.WriteString(subtleStyle.Render(fmt.Sprintf("Page %d/%d • %d records", m.pager.Page, m.pager.TotalPages(), total)))
+	s.WriteString("\n")
+	s.WriteString(subtleStyle.Render(m.filterInfo()))
+	s.WriteString("\n\n")
+
+	if total == 0 {
+		s.WriteString(subtleStyle.Render("No history records"))
+	} else {
+		s.WriteString(m.table.View())
+		s.WriteString("\n\n")
+		s.WriteString(m.detailsView())
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+// filterInfo describes the active filters
+func (m Model) filterInfo() string {
+	series := "All"
+	if m.seriesTitle != "" {
+		series = m.seriesTitle
+	}
+
+	dates := "All"
+	switch {
+	case !m.filter.From.IsZero() && !m.filter.To.IsZero():
+		dates = fmt.Sprintf("%s - %s", m.filter.From.Format(dateFormat), m.filter.To.AddDate(0, 0, -1).Format(dateFormat))
+	case !m.filter.From.IsZero():
+		dates = fmt.Sprintf("since %s", m.filter.From.Format(dateFormat))
+	case !m.filter.To.IsZero():
+		dates = fmt.Sprintf("until %s", m.filter.To.AddDate(0, 0, -1).Format(dateFormat))
+	}
+
+	return fmt.Sprintf("Event: %s • Series: %s • Date: %s", EventType(m.filter.EventType), series, dates)
+}
+
+// detailsView renders the source title and the download details of the selected record
+func (m Model) detailsView() string {
+	record := m.selectedRecord()
+	if record == nil {
+		return ""
+	}
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	lines := []string{titleStyle.Render(record.SourceTitle)}
+
+	var info []string
+	if indexer := record.Data["indexer"]; indexer != "" {
+		info = append(info, fmt.Sprintf("Indexer: %s", indexer))
+	}
+	if client := downloadClient(record); client != "" {
+		info = append(info, fmt.Sprintf("Client: %s", client))
+	}
+	if group := record.Data["releaseGroup"]; group != "" {
+		info = append(info, fmt.Sprintf("Group: %s", group))
+	}
+	if size, err := strconv.ParseUint(record.Data["size"], 10, 64); err == nil && size > 0 {
+		info = append(info, fmt.Sprintf("Size: %s", humanize.IBytes(size)))
+	}
+	info = append(info, fmt.Sprintf("Quality: %s", quality(record.Quality)))
+	lines = append(lines, subtleStyle.Render(strings.Join(info, " • ")))
+
+	switch record.EventType {
+	case sonarrAPI.EpisodeHistoryEventTypeDownloadFolderImported, sonarrAPI.EpisodeHistoryEventTypeSeriesFolderImported:
+		if path := record.Data["importedPath"]; path != "" {
+			lines = append(lines, subtleStyle.Render(fmt.Sprintf("Imported to %s", path)))
+		}
+	case sonarrAPI.EpisodeHistoryEventTypeEpisodeFileRenamed:
+		if path := record.Data["path"]; path != "" {
+			lines = append(lines, subtleStyle.Render(fmt.Sprintf("Renamed to %s", path)))
+		}
+	case sonarrAPI.EpisodeHistoryEventTypeDownloadFailed:
+		if message := record.Data["message"]; message != "" {
+			lines = append(lines, errorStyle.Render(message))
+		}
+	case sonarrAPI.EpisodeHistoryEventTypeEpisodeFileDeleted:
+		if reason := record.Data["reason"]; reason != "" {
+			lines = append(lines, subtleStyle.Render(fmt.Sprintf("Reason: %s", reason)))
+		}
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxHeight(detailsHeight).
+		Render(strings.Join(lines, "\n"))
+}
+
+func downloadClient(record *sonarrAPI.HistoryResource) string {
+	if name := record.Data["downloadClientName"]; name != "" {
+		return name
+	}
+	return record.Data["downloadClient"]
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTable()
+
+	if m.dialog != nil {
+		switch m.state {
+		case stateDateRange:
+			m.dialog.SetSize(width, height)
+		default:
+			m.dialog.SetSize(min(width, 60), height)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package manualimport
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	Toggle     key.Binding
+	ToggleAll  key.Binding
+	Edit       key.Binding
+	Mode       key.Binding
+	Import     key.Binding
+	Reload     key.Binding
+	Quit       key.Binding
+	Back       key.Binding
+	Help       key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Toggle:     key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle")),
+	ToggleAll:  key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "toggle all")),
+	Edit:       key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "edit mapping")),
+	Mode:       key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "move/copy")),
+	Import:     key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "import selected")),
+	Reload:     key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
+	Quit:       key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:       key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.Toggle, k.ToggleAll},
+		{k.Edit, k.Mode, k.Import, k.Reload},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
+type folderKeyMap struct {
+	Submit key.Binding
+	Back   key.Binding
+	Quit   key.Binding
+}
+
+var FolderKeyMap = folderKeyMap{
+	Submit: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "scan folder")),
+	Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	Quit:   key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+}
+
+func (k folderKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Submit, k.Back, k.Quit},
+	}
+}
+
+type mappingKeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	Filter     key.Binding
+	Toggle     key.Binding
+	Select     key.Binding
+	Back       key.Binding
+	Quit       key.Binding
+}
+
+var MappingKeyMap = mappingKeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Filter:     key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+	Toggle:     key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle episode")),
+	Select:     key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Quit:       key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+}
+
+func (k mappingKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.Filter},
+		{k.Toggle, k.Select},
+		{k.Back, k.Quit},
+	}
+}
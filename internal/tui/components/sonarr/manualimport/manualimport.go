@@ -0,0 +1,554 @@
+package manualimport
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/confirm"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type state int
+
+const (
+	stateFolder state = iota + 1
+	stateLoading
+	stateFiles
+	stateMapping
+	stateConfirm
+)
+
+// importStartedMsg is sent after the user confirmed the import
+type importStartedMsg struct {
+	count int
+}
+
+type Model struct {
+	common.EmbedableModel
+
+	client *sonarr.Client
+	title  string
+	// downloadID is empty if the files of a folder are imported
+	downloadID string
+	folder     textinput.Model
+	mode       sonarrAPI.ImportMode
+
+	state   state
+	spinner common.Spinner
+	table   table.Model
+	mapping common.SubModel
+	confirm common.SubModel
+
+	files []*sonarrAPI.ManualImportResource
+	// selected contains the paths of the files to import
+	selected common.Selection[string]
+}
+
+// New returns the manual import of a download.
+// If downloadID is empty, the user is asked for the folder to import from.
+func New(client *sonarr.Client, title, downloadID string, width, height int) common.SubModel {
+	m := Model{
+		client:     client,
+		title:      title,
+		downloadID: downloadID,
+		folder:     textinput.New(),
+		mode:       sonarrAPI.ImportModeMove,
+		state:      stateLoading,
+		spinner:    common.NewSpinner(),
+		table:      common.NewTable(),
+		selected:   make(common.Selection[string]),
+	}
+	if downloadID == "" {
+		m.state = stateFolder
+	}
+
+	m.folder.Prompt = "Folder: "
+	m.folder.Placeholder = "e.g. /downloads/complete/tv"
+	m.folder.Cursor.Style = lipgloss.NewStyle().Foreground(styles.SonarrBlue)
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m *Model) Init() tea.Cmd {
+	if m.state == stateFolder {
+		return tea.Batch(
+			statusbar.NewHelpCmd(FolderKeyMap.FullHelp()),
+			m.folder.Focus(),
+		)
+	}
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.fetchFiles(),
+	)
+}
+
+func (m Model) fetchFiles() tea.Cmd {
+	if m.downloadID != "" {
+		return m.client.FetchManualImport(m.downloadID, "")
+	}
+	return m.client.FetchManualImport("", strings.TrimSpace(m.folder.Value()))
+}
+
+func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateFolder:
+			switch {
+			case key.Matches(msg, FolderKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, FolderKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, FolderKeyMap.Submit):
+				if strings.TrimSpace(m.folder.Value()) == "" {
+					return m, statusbar.NewErrCmd("The folder must not be empty")
+				}
+				m.state = stateLoading
+				m.folder.Blur()
+				return m, tea.Batch(
+					statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+					m.spinner.Tick,
+					m.fetchFiles(),
+				)
+			}
+
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+
+		case stateFiles:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				m.state = stateLoading
+				return m, tea.Batch(
+					m.spinner.Tick,
+					m.fetchFiles(),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.Toggle):
+				if file := m.selectedFile(); file != nil {
+					m.selected.Toggle(file.Path)
+					m.updateTable()
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.ToggleAll):
+				paths := make([]string, len(m.files))
+				for i, file := range m.files {
+					paths[i] = file.Path
+				}
+				m.selected.ToggleAll(paths)
+				m.updateTable()
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Mode):
+				if m.mode == sonarrAPI.ImportModeMove {
+					m.mode = sonarrAPI.ImportModeCopy
+				} else {
+					m.mode = sonarrAPI.ImportModeMove
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Edit):
+				if file := m.selectedFile(); file != nil {
+					return m, m.editMapping(file)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Import):
+				return m, m.confirmImport()
+			}
+		}
+
+	case sonarr.FetchManualImportResult:
+		if m.state != stateLoading {
+			return m, nil
+		}
+		m.state = stateFiles
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch the files to import")
+		}
+		// files without rejections are selected by default
+		m.files = msg.Files
+		m.selected.Clear()
+		for _, file := range m.files {
+			m.selected.Set(file.Path, len(file.Rejections) == 0 && isMapped(file))
+		}
+		m.updateTable()
+		return m, nil
+
+	case mappingMsg:
+		for _, file := range m.files {
+			if file.Path != msg.path {
+				continue
+			}
+			file.Series = msg.series
+			file.SeasonNumber = &msg.season
+			file.Episodes = msg.episodes
+			// the rejections were based on the old mapping, sonarr checks the file again during the import
+			file.Rejections = nil
+			m.selected.Set(file.Path, true)
+		}
+		m.updateTable()
+		return m, nil
+
+	case importStartedMsg:
+		m.IsBack = true
+		message := fmt.Sprintf("Importing %d files...", msg.count)
+		if msg.count == 1 {
+			message = "Importing 1 file..."
+		}
+		return m, statusbar.NewMessageCmd(message, statusbar.WithMessageTimeout(2))
+	}
+
+	switch m.state {
+	case stateFolder:
+		var cmd tea.Cmd
+		m.folder, cmd = m.folder.Update(msg)
+		return m, cmd
+
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateFiles:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+
+	case stateMapping:
+		var cmd tea.Cmd
+		m.mapping, cmd = m.mapping.Update(msg)
+
+		if m.mapping.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.mapping.Back() {
+			m.state = stateFiles
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+
+	case stateConfirm:
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+
+		if m.confirm.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.confirm.Back() {
+			m.state = stateFiles
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m *Model) editMapping(file *sonarrAPI.ManualImportResource) tea.Cmd {
+	m.state = stateMapping
+	m.mapping = newMapping(m.client, file, min(m.Width, 80), m.Height)
+	return m.mapping.Init()
+}
+
+// confirmImport asks the user before the selected files are imported
+func (m *Model) confirmImport() tea.Cmd {
+	files := make([]*sonarrAPI.ManualImportResource, 0, len(m.files))
+	for _, file := range m.files {
+		if m.selected[file.Path] {
+			files = append(files, file)
+		}
+	}
+	if len(files) == 0 {
+		return statusbar.NewErrCmd("No files selected")
+	}
+	for _, file := range files {
+		if !isMapped(file) {
+			return statusbar.NewErrCmd(fmt.Sprintf("Select a series and episodes for %s", filepath.Base(file.Path)))
+		}
+	}
+
+	question := fmt.Sprintf("%s %d files?", common.Title(string(m.mode)), len(files))
+	if len(files) == 1 {
+		question = fmt.Sprintf("%s 1 file?", common.Title(string(m.mode)))
+	}
+
+	count := len(files)
+	m.state = stateConfirm
+	m.confirm = confirm.New("Manual import", question, tea.Batch(
+		m.client.ManualImport(files, m.mode),
+		func() tea.Msg { return importStartedMsg{count: count} },
+	), styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.confirm.Init()
+}
+
+func isMapped(file *sonarrAPI.ManualImportResource) bool {
+	return file.Series != nil && len(file.Episodes) > 0
+}
+
+func (m Model) countSelected() int {
+	var count int
+	for _, file := range m.files {
+		if m.selected[file.Path] {
+			count++
+		}
+	}
+	return count
+}
+
+func (m Model) selectedFile() *sonarrAPI.ManualImportResource {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.files) {
+		return nil
+	}
+	return m.files[cursor]
+}
+
+// updateTable sets the rows and columns of the table and distributes the available height
+func (m *Model) updateTable() {
+	rows := make([]table.Row, 0, len(m.files))
+	for _, file := range m.files {
+		checkbox := "⬜"
+		if m.selected[file.Path] {
+			checkbox = "✅"
+		}
+		rows = append(rows, table.Row{
+			checkbox,
+			relativePath(file),
+			seriesTitle(file),
+			episodeNumbers(file),
+			quality(file.Quality),
+			languages(file.Languages),
+			humanize.IBytes(uint64(file.Size)),
+			rejections(file),
+		})
+	}
+
+	// the title, the table header and the spacing take 5 lines
+	common.FitTable(&m.table, boxStyle, m.Width, m.Height, detailsHeight+5, rows, []string{"", "File", "Series", "Episodes", "Quality", "Languages", "Size", "Rejections"}, 1)
+}
+
+func relativePath(file *sonarrAPI.ManualImportResource) string {
+	if file.RelativePath != "" {
+		return file.RelativePath
+	}
+	return filepath.Base(file.Path)
+}
+
+func seriesTitle(file *sonarrAPI.ManualImportResource) string {
+	if file.Series == nil {
+		return "-"
+	}
+	return file.Series.Title
+}
+
+// episodeNumbers returns the episodes the file is mapped to, e.g. S01E02-E03
+func episodeNumbers(file *sonarrAPI.ManualImportResource) string {
+	if len(file.Episodes) == 0 {
+		if file.SeasonNumber != nil {
+			return fmt.Sprintf("S%02d", *file.SeasonNumber)
+		}
+		return "-"
+	}
+	first, last := file.Episodes[0], file.Episodes[len(file.Episodes)-1]
+	s := fmt.Sprintf("S%02dE%02d", first.SeasonNumber, first.EpisodeNumber)
+	if len(file.Episodes) > 1 {
+		s += fmt.Sprintf("-E%02d", last.EpisodeNumber)
+	}
+	return s
+}
+
+func quality(q *sonarrAPI.QualityModel) string {
+	if q == nil || q.Quality == nil {
+		return "Unknown"
+	}
+	return q.Quality.Name
+}
+
+func languages(languages []sonarrAPI.Language) string {
+	if len(languages) == 0 {
+		return "Unknown"
+	}
+	names := make([]string, len(languages))
+	for i, language := range languages {
+		names[i] = language.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func rejections(file *sonarrAPI.ManualImportResource) string {
+	if len(file.Rejections) == 0 {
+		return "-"
+	}
+	return fmt.Sprint(len(file.Rejections))
+}
+
+// detailsHeight is the height of the details of the selected file
+const detailsHeight = 4
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Padding(0, 1).
+			Background(styles.PurpleColor)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	warningStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FFD700"))
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(styles.ErrorColor)
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateFolder:
+		return m.folderView()
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateFiles:
+		return m.filesView()
+	case stateMapping:
+		fg := m.mapping.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		// make sure background fills the whole screen
+		bg := m.filesView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	case stateConfirm:
+		fg := m.confirm.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		bg := m.filesView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	}
+	return ":("
+}
+
+func (m Model) folderView() string {
+	var s strings.Builder
+	s.WriteString(titleStyle.Render(fmt.Sprintf("%s ❯ Manual Import", m.title)))
+	s.WriteString("\n\n")
+	s.WriteString(m.folder.View())
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+func (m Model) filesView() string {
+	var s strings.Builder
+
+	s.WriteString(titleStyle.Render(fmt.Sprintf("%s ❯ Manual Import", m.title)))
+	s.WriteString(subtleStyle.Render(fmt.Sprintf(" %d/%d files selected • %s", m.countSelected(), len(m.files), common.Title(string(m.mode)))))
+	s.WriteString("\n\n")
+
+	if len(m.files) == 0 {
+		s.WriteString(subtleStyle.Render("No files found to import"))
+	} else {
+		s.WriteString(m.table.View())
+		s.WriteString("\n\n")
+		s.WriteString(m.detailsView())
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+// detailsView renders the full path and the rejections of the selected file
+func (m Model) detailsView() string {
+	file := m.selectedFile()
+	if file == nil {
+		return ""
+	}
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	lines := []string{subtleStyle.Render(file.Path)}
+	for _, rejection := range file.Rejections {
+		style := errorStyle
+		if rejection.Type == sonarrAPI.TemporaryRejection {
+			style = warningStyle
+		}
+		lines = append(lines, style.Render(rejection.Reason))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxHeight(detailsHeight).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.folder.Width = max(width-boxStyle.GetHorizontalFrameSize()-lipgloss.Width(m.folder.Prompt)-1, 10)
+	m.updateTable()
+
+	if m.mapping != nil {
+		m.mapping.SetSize(min(width, 80), height)
+	}
+
+	if m.confirm != nil {
+		m.confirm.SetSize(min(width, 60), height)
+	}
+}
@@ -0,0 +1,341 @@
+package manualimport
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+var dialogStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder(), true).
+	BorderForeground(styles.SonarrBlue).
+	Padding(1, 2)
+
+// mappingMsg is sent when the user mapped a file to new episodes
+type mappingMsg struct {
+	path     string
+	series   *sonarrAPI.SeriesResource
+	season   int32
+	episodes []*sonarrAPI.EpisodeResource
+}
+
+type mappingStep int
+
+const (
+	stepSeries mappingStep = iota + 1
+	stepSeason
+	stepLoading
+	stepEpisodes
+)
+
+type mappingItem struct {
+	id        int32
+	label     string
+	checkable bool
+	checked   bool
+}
+
+func (i mappingItem) FilterValue() string { return i.label }
+
+type mappingDelegate struct{}
+
+func (d mappingDelegate) Height() int { return 1 }
+
+func (d mappingDelegate) Spacing() int { return 0 }
+
+var itemStyles = list.NewDefaultItemStyles()
+
+func (d mappingDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(mappingItem)
+	if !ok {
+		return
+	}
+
+	text := i.label
+	if i.checkable {
+		text = fmt.Sprintf("⬜ %s", i.label)
+		if i.checked {
+			text = fmt.Sprintf("✅ %s", i.label)
+		}
+	}
+
+	if index == m.Index() {
+		fmt.Fprint(w, itemStyles.SelectedTitle.Render(text))
+		return
+	}
+	fmt.Fprint(w, itemStyles.NormalTitle.Render(text))
+}
+
+func (d mappingDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+// mapping lets the user choose the series, season and episodes of a file
+type mapping struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	file    *sonarrAPI.ManualImportResource
+	step    mappingStep
+	list    list.Model
+	spinner common.Spinner
+
+	series   *sonarrAPI.SeriesResource
+	season   int32
+	episodes []*sonarrAPI.EpisodeResource
+}
+
+func newMapping(client *sonarr.Client, file *sonarrAPI.ManualImportResource, width, height int) *mapping {
+	m := mapping{
+		client:  client,
+		file:    file,
+		list:    sonarr_list.New("", nil, mappingDelegate{}, width, height),
+		spinner: common.NewSpinner(),
+	}
+	m.list.SetShowStatusBar(false)
+	m.list.FilterInput.Prompt = "Search: "
+	m.SetSize(width, height)
+	m.showSeries()
+
+	return &m
+}
+
+func (m mapping) Init() tea.Cmd {
+	return statusbar.NewHelpCmd(MappingKeyMap.FullHelp())
+}
+
+func (m *mapping) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.list.SettingFilter() {
+			break
+		}
+		switch {
+		case key.Matches(msg, MappingKeyMap.Quit):
+			m.IsQuit = true
+			return m, nil
+
+		case key.Matches(msg, MappingKeyMap.Back):
+			if m.list.IsFiltered() {
+				break
+			}
+			switch m.step {
+			case stepSeries:
+				m.IsBack = true
+			case stepSeason:
+				m.showSeries()
+			case stepLoading, stepEpisodes:
+				m.showSeasons()
+			}
+			return m, nil
+
+		case key.Matches(msg, MappingKeyMap.Toggle):
+			if m.step != stepEpisodes {
+				return m, nil
+			}
+			item, ok := m.list.SelectedItem().(mappingItem)
+			if !ok {
+				return m, nil
+			}
+			item.checked = !item.checked
+			// the index of the selected item is relative to the visible items
+			for i, listItem := range m.list.Items() {
+				if listItem.(mappingItem).id == item.id {
+					m.list.SetItem(i, item)
+					break
+				}
+			}
+			return m, nil
+
+		case key.Matches(msg, MappingKeyMap.Select):
+			return m, m.selectItem()
+		}
+
+	case sonarr.FetchImportEpisodesResult:
+		if m.step != stepLoading || m.series == nil || msg.SeriesID != m.series.ID || msg.SeasonNumber != m.season {
+			return m, nil
+		}
+		if msg.Error != nil {
+			m.showSeasons()
+			return m, statusbar.NewErrCmd("Failed to fetch episodes")
+		}
+		m.episodes = msg.Episodes
+		m.showEpisodes()
+		return m, nil
+	}
+
+	if m.step == stepLoading {
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+// selectItem moves on to the next step or finishes the mapping
+func (m *mapping) selectItem() tea.Cmd {
+	item, ok := m.list.SelectedItem().(mappingItem)
+	if !ok && m.step != stepEpisodes {
+		return nil
+	}
+
+	switch m.step {
+	case stepSeries:
+		for _, series := range m.client.GetSeries() {
+			if series.ID == item.id {
+				m.series = series
+				m.showSeasons()
+				break
+			}
+		}
+		return nil
+
+	case stepSeason:
+		m.season = item.id
+		m.step = stepLoading
+		return tea.Batch(
+			m.spinner.Tick,
+			m.client.FetchImportEpisodes(m.series.ID, m.season),
+		)
+
+	case stepEpisodes:
+		episodes := make([]*sonarrAPI.EpisodeResource, 0)
+		for _, listItem := range m.list.Items() {
+			if !listItem.(mappingItem).checked {
+				continue
+			}
+			for _, episode := range m.episodes {
+				if episode.ID == listItem.(mappingItem).id {
+					episodes = append(episodes, episode)
+				}
+			}
+		}
+		if len(episodes) == 0 {
+			return statusbar.NewErrCmd("Select at least one episode")
+		}
+		m.IsBack = true
+		msg := mappingMsg{
+			path:     m.file.Path,
+			series:   m.series,
+			season:   m.season,
+			episodes: episodes,
+		}
+		return func() tea.Msg { return msg }
+	}
+
+	return nil
+}
+
+func (m *mapping) showSeries() {
+	m.step = stepSeries
+	m.list.ResetFilter()
+	m.list.Title = "Series"
+
+	series := m.client.GetSeries()
+	items := make([]list.Item, len(series))
+	var cursor int
+	for i, s := range series {
+		items[i] = mappingItem{id: s.ID, label: s.Title}
+		if m.selectedSeriesID() == s.ID {
+			cursor = i
+		}
+	}
+	m.list.SetItems(items)
+	m.list.Select(cursor)
+}
+
+func (m *mapping) showSeasons() {
+	m.step = stepSeason
+	m.list.ResetFilter()
+	m.list.Title = m.series.Title
+
+	seasons := make([]int32, 0, len(m.series.Seasons))
+	for _, season := range m.series.Seasons {
+		seasons = append(seasons, season.SeasonNumber)
+	}
+	sort.Slice(seasons, func(i, j int) bool { return seasons[i] < seasons[j] })
+
+	items := make([]list.Item, len(seasons))
+	var cursor int
+	for i, season := range seasons {
+		label := fmt.Sprintf("Season %d", season)
+		if season == 0 {
+			label = "Specials"
+		}
+		items[i] = mappingItem{id: season, label: label}
+		if m.file.SeasonNumber != nil && *m.file.SeasonNumber == season {
+			cursor = i
+		}
+	}
+	m.list.SetItems(items)
+	m.list.Select(cursor)
+}
+
+func (m *mapping) showEpisodes() {
+	m.step = stepEpisodes
+	m.list.ResetFilter()
+	m.list.Title = fmt.Sprintf("%s ❯ Season %d", m.series.Title, m.season)
+
+	// preselect the episodes the file is currently mapped to
+	mapped := make(map[int32]bool, len(m.file.Episodes))
+	for _, episode := range m.file.Episodes {
+		mapped[episode.ID] = true
+	}
+
+	items := make([]list.Item, len(m.episodes))
+	var cursor int
+	for i, episode := range m.episodes {
+		items[i] = mappingItem{
+			id:        episode.ID,
+			label:     fmt.Sprintf("%d. %s", episode.EpisodeNumber, episode.Title),
+			checkable: true,
+			checked:   mapped[episode.ID],
+		}
+		if mapped[episode.ID] && cursor == 0 {
+			cursor = i
+		}
+	}
+	m.list.SetItems(items)
+	m.list.Select(cursor)
+}
+
+func (m mapping) selectedSeriesID() int32 {
+	if m.series != nil {
+		return m.series.ID
+	}
+	if m.file.Series != nil {
+		return m.file.Series.ID
+	}
+	return 0
+}
+
+func (m *mapping) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+	m.list.SetSize(
+		width-dialogStyle.GetHorizontalFrameSize(),
+		height-dialogStyle.GetVerticalFrameSize(),
+	)
+}
+
+func (m mapping) View() string {
+	if m.step == stepLoading {
+		return dialogStyle.Render(m.spinner.View() + " Loading episodes...")
+	}
+	if len(m.list.Items()) == 0 {
+		return dialogStyle.Render(lipgloss.NewStyle().Foreground(styles.SubtleColor).Render("Nothing to select"))
+	}
+	return dialogStyle.Render(m.list.View())
+}
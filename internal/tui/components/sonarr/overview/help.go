@@ -16,6 +16,18 @@ type KeyMap struct {
 	Reload     key.Binding
 	Filter     key.Binding
 	AddNew     key.Binding
+	Mark       key.Binding
+	MarkUp     key.Binding
+	MarkDown   key.Binding
+	MarkAll    key.Binding
+	BulkEdit   key.Binding
+	BulkSearch key.Binding
+	BulkDelete key.Binding
+	SeasonPass key.Binding
+	TagFilter  key.Binding
+	Sort       key.Binding
+	SortOrder  key.Binding
+	Filters    key.Binding
 }
 
 var DefaultKeyMap = KeyMap{
@@ -30,6 +42,18 @@ var DefaultKeyMap = KeyMap{
 	Reload:     key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload list")),
 	Filter:     key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
 	AddNew:     key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "add new series")),
+	Mark:       key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "select")),
+	MarkUp:     key.NewBinding(key.WithKeys("shift+up", "K"), key.WithHelp("shift+↑/K", "select up")),
+	MarkDown:   key.NewBinding(key.WithKeys("shift+down", "J"), key.WithHelp("shift+↓/J", "select down")),
+	MarkAll:    key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "select all filtered")),
+	BulkEdit:   key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "edit selected")),
+	BulkSearch: key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "search selected")),
+	BulkDelete: key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "delete selected")),
+	SeasonPass: key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "change monitoring of selected")),
+	TagFilter:  key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "filter by tags")),
+	Sort:       key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort by")),
+	SortOrder:  key.NewBinding(key.WithKeys("S"), key.WithHelp("S", "reverse order")),
+	Filters:    key.NewBinding(key.WithKeys("F"), key.WithHelp("F", "cycle filter")),
 }
 
 func (k KeyMap) FullHelp() [][]key.Binding {
@@ -38,5 +62,38 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 		{k.Filter, k.Select, k.Reload},
 		{k.Help, k.Back, k.Quit},
 		{k.AddNew},
+		{k.Sort, k.SortOrder, k.Filters, k.TagFilter},
+		{k.Mark, k.MarkUp, k.MarkDown, k.MarkAll},
+		{k.BulkEdit, k.BulkSearch, k.BulkDelete, k.SeasonPass},
+	}
+}
+
+type tagFilterKeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	Filter     key.Binding
+	Toggle     key.Binding
+	Clear      key.Binding
+	Apply      key.Binding
+	Back       key.Binding
+	Quit       key.Binding
+}
+
+var TagFilterKeyMap = tagFilterKeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Filter:     key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+	Toggle:     key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle")),
+	Clear:      key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "clear")),
+	Apply:      key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "apply")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	Quit:       key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+}
+
+func (k tagFilterKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.Filter},
+		{k.Toggle, k.Clear},
+		{k.Apply, k.Back, k.Quit},
 	}
 }
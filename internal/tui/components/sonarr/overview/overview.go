@@ -2,18 +2,25 @@ package overview
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/logging"
+	appstate "github.com/jon4hz/submarr/internal/state"
 	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/addseries"
 	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/removeseries"
 	"github.com/jon4hz/submarr/internal/tui/components/sonarr/search"
 	"github.com/jon4hz/submarr/internal/tui/components/sonarr/season"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/seasonpass"
 	"github.com/jon4hz/submarr/internal/tui/components/sonarr/series"
 	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
 	"github.com/jon4hz/submarr/internal/tui/styles"
 	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
 	zone "github.com/lrstanley/bubblezone"
@@ -29,6 +36,10 @@ const (
 	stateSeriesDetails
 	stateSeason
 	stateSearch
+	stateBulkEdit
+	stateBulkDelete
+	stateTagFilter
+	stateSeasonPass
 )
 
 type Model struct {
@@ -43,14 +54,38 @@ type Model struct {
 	spinner common.Spinner
 
 	state state
+
+	// selected contains the ids of the series selected for a bulk action
+	selected common.Selection[int32]
+	// tags contains the ids of the tags the series are filtered by, a series must have at least one of them
+	tags []int32
+	// reselect is the id of the series to select again, once the filter was applied to the refreshed items
+	reselect int32
+
+	// sortKey, sortReverse and filter are persisted in the app state
+	appState    *appstate.State
+	sortKey     sonarr.SeriesSortKey
+	sortReverse bool
+	filter      sonarr.SeriesFilter
 }
 
-func New(c *sonarr.Client, width, height int) common.SubModel {
+func New(c *sonarr.Client, width, height int) common.TabModel {
+	appState, err := appstate.Load()
+	if err != nil {
+		logging.Log.Error("Failed to load state", "err", err)
+	}
+
+	selected := make(common.Selection[int32])
 	m := Model{
-		state:      stateLoading,
-		client:     c,
-		seriesList: sonarr_list.New("Overview", nil, series.Delegate{}, width, height),
-		spinner:    common.NewSpinner(),
+		state:       stateLoading,
+		client:      c,
+		seriesList:  sonarr_list.New("Overview", nil, series.Delegate{Selected: selected, Client: c}, width, height),
+		spinner:     common.NewSpinner(),
+		selected:    selected,
+		appState:    appState,
+		sortKey:     sonarr.ParseSeriesSortKey(appState.SonarrOverview.SortKey),
+		sortReverse: appState.SonarrOverview.SortReverse,
+		filter:      sonarr.ParseSeriesFilter(appState.SonarrOverview.Filter),
 	}
 
 	m.SetSize(width, height)
@@ -74,7 +109,7 @@ func (m Model) Init() tea.Cmd {
 	)
 }
 
-func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+func (m *Model) Update(msg tea.Msg) (common.TabModel, tea.Cmd) {
 	var cmds []tea.Cmd
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
@@ -92,6 +127,9 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 		case stateSeries:
 			switch {
 			case key.Matches(msg, DefaultKeyMap.Back):
+				if !m.seriesList.SettingFilter() && len(m.selected) > 0 {
+					return m, m.clearMarks()
+				}
 				if !m.seriesList.SettingFilter() && !m.seriesList.IsFiltered() {
 					m.IsBack = true
 				}
@@ -119,6 +157,71 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 
 			case key.Matches(msg, DefaultKeyMap.AddNew):
 				return m, m.addNewSeries()
+
+			case key.Matches(msg, DefaultKeyMap.Mark):
+				if !m.seriesList.SettingFilter() {
+					return m, m.toggleMark()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.MarkUp):
+				if !m.seriesList.SettingFilter() {
+					return m, m.markRange(-1)
+				}
+
+			case key.Matches(msg, DefaultKeyMap.MarkDown):
+				if !m.seriesList.SettingFilter() {
+					return m, m.markRange(1)
+				}
+
+			case key.Matches(msg, DefaultKeyMap.MarkAll):
+				if !m.seriesList.SettingFilter() {
+					return m, m.markAll()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.BulkEdit):
+				if !m.seriesList.SettingFilter() {
+					return m, m.bulkEdit()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.BulkSearch):
+				if !m.seriesList.SettingFilter() {
+					return m, m.bulkSearch()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.BulkDelete):
+				if !m.seriesList.SettingFilter() {
+					return m, m.bulkDelete()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.SeasonPass):
+				if !m.seriesList.SettingFilter() {
+					return m, m.bulkSeasonPass()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.Sort):
+				if !m.seriesList.SettingFilter() {
+					m.sortKey = nextSortKey(m.sortKey)
+					return m, m.viewChanged(fmt.Sprintf("Sorted by %s", m.sortKey))
+				}
+
+			case key.Matches(msg, DefaultKeyMap.SortOrder):
+				if !m.seriesList.SettingFilter() {
+					m.sortReverse = !m.sortReverse
+					return m, m.viewChanged(fmt.Sprintf("Sorted by %s %s", m.sortKey, m.sortArrow()))
+				}
+
+			case key.Matches(msg, DefaultKeyMap.Filters):
+				if !m.seriesList.SettingFilter() {
+					m.filter = nextFilter(m.filter)
+					return m, m.viewChanged(fmt.Sprintf("Showing %d series • %s", len(m.visibleSeries()), m.filter))
+				}
+
+			case key.Matches(msg, DefaultKeyMap.TagFilter):
+				if !m.seriesList.SettingFilter() {
+					m.state = stateTagFilter
+					m.submodel = newTagFilter(m.client.GetTags(), m.tags, min(m.Width, 60), m.Height)
+					return m, m.submodel.Init()
+				}
 			}
 
 		case stateSeriesLoading:
@@ -179,22 +282,55 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 
 	case sonarr.FetchSeriesResult:
 		switch m.state {
-		case stateSeriesDetails:
-			break
-		default:
+		case stateLoading, stateSeries:
 			m.seriesList.StopSpinner()
 
 			m.state = stateSeries
 			if msg.Error != nil {
 				cmds = append(cmds, statusbar.NewErrCmd("Failed to fetch series"))
 			} else {
-				cmds = append(cmds, m.seriesList.SetItems(msg.Items))
+				cmds = append(cmds, m.refreshItems())
 			}
 			cmds = append(cmds, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()))
 
 			return m, tea.Batch(cmds...)
+
+		default:
+			// the series were reloaded in the background, don't leave the current view
+			if msg.Error == nil {
+				cmds = append(cmds, m.refreshItems())
+			}
+		}
+
+	case sonarr.EditSeriesResult:
+		// the series view shows the result, only the list must be updated
+		if msg.Error == nil {
+			cmds = append(cmds, m.refreshItems())
 		}
 
+	case sonarr.LiveUpdateMsg:
+		// the submodel still gets the update, e.g. to refresh the open series
+		if m.state != stateLoading && msg.Affects(sonarrAPI.SignalREventSeries, sonarrAPI.SignalREventEpisode, sonarrAPI.SignalREventEpisodeFile) {
+			cmds = append(cmds, m.client.ReloadSeries())
+		}
+
+	case sonarr.ReloadSeriesResult:
+		// a background reload only updates the items, the cursor and the filter stay as they are
+		if msg.Error == nil && m.state != stateLoading {
+			return m, m.refreshItemsKeepCursor()
+		}
+		return m, nil
+
+	case list.FilterMatchesMsg:
+		// the matches of the nested lists belong to the submodel
+		if m.state == stateSeries || m.seriesList.SettingFilter() {
+			return m, m.updateSeriesFilter(msg)
+		}
+
+	case seriesFilterMatchesMsg:
+		// the matches must reach the list, even if an overlay is shown on top of it
+		return m, m.updateSeriesFilter(list.FilterMatchesMsg(msg))
+
 	case search.SeriesAlreadyAddedMsg:
 		switch m.state {
 		case stateSearch:
@@ -209,7 +345,7 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 				cmds = append(cmds, statusbar.NewErrCmd("Failed to add series"))
 			} else {
 				cmds = append(cmds,
-					m.seriesList.SetItems(msg.Items),
+					m.refreshItems(),
 					statusbar.NewMessageCmd(fmt.Sprintf("Added Series: %s", msg.AddedTitle)),
 					statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
 				)
@@ -219,8 +355,11 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 
 	case sonarr.DeleteSeriesResult:
 		switch m.state {
-		case stateSeriesDetails:
+		case stateSeriesDetails, stateBulkDelete:
 			m.state = stateSeries
+			if msg.Error == nil {
+				m.clearMarks()
+			}
 			if msg.Error != nil {
 				return m, tea.Batch(
 					statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
@@ -228,12 +367,82 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 				)
 			}
 			return m, tea.Batch(
-				m.seriesList.SetItems(msg.Items),
+				m.refreshItems(),
 				statusbar.NewMessageCmd(fmt.Sprintf("Deleted Series: %s", msg.DeletedTitle)),
 				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
 			)
 		}
 
+	case sonarr.BulkEditSeriesResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		return m, tea.Batch(
+			m.refreshItems(),
+			statusbar.NewMessageCmd(fmt.Sprintf("Saved %d series", msg.Count)),
+		)
+
+	case sonarr.BulkDeleteSeriesResult:
+		if m.state != stateBulkDelete {
+			return m, nil
+		}
+		m.state = stateSeries
+		if msg.Error != nil {
+			return m, tea.Batch(
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+				statusbar.NewErrCmd(msg.Error.Error()),
+			)
+		}
+		m.clearMarks()
+		return m, tea.Batch(
+			m.refreshItems(),
+			statusbar.NewMessageCmd(fmt.Sprintf("Deleted %d series", msg.Count)),
+			statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		)
+
+	case sonarr.SeasonPassResult:
+		// the series view handles the result of a single series itself
+		if m.state != stateSeries {
+			break
+		}
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		m.clearMarks()
+		return m, tea.Batch(
+			m.client.FetchSeries(),
+			m.seriesList.StartSpinner(),
+			statusbar.NewMessageCmd(fmt.Sprintf("Changed monitoring of %d series to %s", msg.Count, msg.Monitor)),
+		)
+
+	case selectTagsMsg:
+		m.tags = msg.ids
+		cmds = append(cmds, m.refreshItems())
+		if len(m.tags) > 0 {
+			cmds = append(cmds, statusbar.NewMessageCmd(
+				fmt.Sprintf("%d series tagged %s", len(m.seriesList.Items()), strings.Join(m.client.TagLabels(m.tags), ", ")),
+				statusbar.WithMessageTimeout(2),
+			))
+		}
+
+	case sonarr.SaveTagResult:
+		if msg.Error == nil {
+			m.updateTitle()
+		}
+
+	case sonarr.DeleteTagResult:
+		// the deleted tag can no longer be used to filter the series
+		if msg.Error == nil && len(m.tags) > 0 {
+			var tags []int32
+			for _, id := range m.tags {
+				if m.client.GetTagByID(id) != nil {
+					tags = append(tags, id)
+				}
+			}
+			m.tags = tags
+			cmds = append(cmds, m.refreshItems())
+		}
+
 	case series.SelectSeasonMsg:
 		return m, m.selectSeason(m.client.GetSerie().Seasons[msg])
 	}
@@ -265,7 +474,7 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 
 		if m.submodel.Back() {
 			switch m.state {
-			case stateSeriesLoading, stateSeriesDetails, stateSearch:
+			case stateSeriesLoading, stateSeriesDetails, stateSearch, stateBulkEdit, stateBulkDelete, stateTagFilter, stateSeasonPass:
 				m.state = stateSeries
 				cmds = append(cmds,
 					// reset the help of the statusbar
@@ -308,6 +517,280 @@ func (m *Model) addNewSeries() tea.Cmd {
 	return m.submodel.Init()
 }
 
+// currentSeries returns the visible series at the cursor
+func (m Model) currentSeries() *sonarrAPI.SeriesResource {
+	item, ok := m.seriesList.SelectedItem().(sonarr.SeriesItem)
+	if !ok {
+		return nil
+	}
+	return item.Series
+}
+
+// selectedSeries returns all marked series, including the ones hidden by the filters
+func (m Model) selectedSeries() []*sonarrAPI.SeriesResource {
+	var selected []*sonarrAPI.SeriesResource
+	// the items of the list are already filtered, so all series of the client are checked
+	for _, s := range m.client.GetSeries() {
+		if m.selected[s.ID] {
+			selected = append(selected, s)
+		}
+	}
+	return selected
+}
+
+func (m *Model) toggleMark() tea.Cmd {
+	serie := m.currentSeries()
+	if serie == nil {
+		return nil
+	}
+	m.selected.Toggle(serie.ID)
+	return m.markingChanged()
+}
+
+// markRange marks the current series, moves the cursor into the given direction and marks the next one
+func (m *Model) markRange(direction int) tea.Cmd {
+	serie := m.currentSeries()
+	if serie == nil {
+		return nil
+	}
+	m.selected.Set(serie.ID, true)
+	if direction < 0 {
+		m.seriesList.CursorUp()
+	} else {
+		m.seriesList.CursorDown()
+	}
+	if serie := m.currentSeries(); serie != nil {
+		m.selected.Set(serie.ID, true)
+	}
+	return m.markingChanged()
+}
+
+// markAll marks all series matching the filter, if they are all marked already, they are unmarked
+func (m *Model) markAll() tea.Cmd {
+	visible := m.seriesList.VisibleItems()
+	ids := make([]int32, 0, len(visible))
+	for _, listItem := range visible {
+		if item, _ := listItem.(sonarr.SeriesItem); item.Series != nil {
+			ids = append(ids, item.Series.ID)
+		}
+	}
+	m.selected.ToggleAll(ids)
+	return m.markingChanged()
+}
+
+func (m *Model) clearMarks() tea.Cmd {
+	m.selected.Clear()
+	return m.markingChanged()
+}
+
+// markingChanged updates the title of the list and shows the number of marked series in the statusbar
+func (m *Model) markingChanged() tea.Cmd {
+	m.updateTitle()
+	count := len(m.selectedSeries())
+	if count == 0 {
+		return nil
+	}
+	return statusbar.NewMessageCmd(fmt.Sprintf("%d series selected", count), statusbar.WithMessageTimeout(2))
+}
+
+// updateTitle shows the sorting, the active filters and the number of marked series in the title of the list
+func (m *Model) updateTitle() {
+	title := "Overview"
+	if m.sortKey != sonarr.SortByTitle || m.sortReverse {
+		title += fmt.Sprintf(" • %s %s", m.sortKey, m.sortArrow())
+	}
+	if m.filter != sonarr.FilterAll {
+		title += fmt.Sprintf(" • %s", m.filter)
+	}
+	if len(m.tags) > 0 {
+		title += " • #" + strings.Join(m.client.TagLabels(m.tags), " #")
+	}
+	if count := len(m.selectedSeries()); count > 0 {
+		title += fmt.Sprintf(" • %d selected", count)
+	}
+	m.seriesList.Title = title
+}
+
+// refreshItems sets the series of the client as items of the list.
+// The series are filtered and sorted, so the items of the results aren't used directly.
+func (m *Model) refreshItems() tea.Cmd {
+	visible := m.visibleSeries()
+	items := make([]list.Item, len(visible))
+	for i, s := range visible {
+		items[i] = sonarr.SeriesItem{Series: s}
+	}
+	cmd := m.seriesList.SetItems(items)
+	m.updateTitle()
+	return seriesFilterCmd(cmd)
+}
+
+// seriesFilterMatchesMsg holds the filter matches of the series list.
+// Unlike list.FilterMatchesMsg, it can't be mistaken for the matches of a nested list.
+type seriesFilterMatchesMsg list.FilterMatchesMsg
+
+// seriesFilterCmd marks the filter matches returned by cmd as matches of the series list
+func seriesFilterCmd(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg := cmd()
+		if matches, ok := msg.(list.FilterMatchesMsg); ok {
+			return seriesFilterMatchesMsg(matches)
+		}
+		return msg
+	}
+}
+
+// updateSeriesFilter passes the filter matches to the series list
+func (m *Model) updateSeriesFilter(msg list.FilterMatchesMsg) tea.Cmd {
+	var cmd tea.Cmd
+	m.seriesList, cmd = m.seriesList.Update(msg)
+	if m.reselect != 0 {
+		m.selectSeriesByID(m.reselect)
+		m.reselect = 0
+	}
+	return cmd
+}
+
+// refreshItemsKeepCursor refreshes the items and moves the cursor back to the series it was on
+func (m *Model) refreshItemsKeepCursor() tea.Cmd {
+	var id int32
+	if serie := m.currentSeries(); serie != nil {
+		id = serie.ID
+	}
+	cmd := m.refreshItems()
+	if m.seriesList.FilterState() != list.Unfiltered {
+		// the filter is applied asynchronously, so the cursor can only be restored once the matches arrive
+		m.reselect = id
+		return cmd
+	}
+	m.selectSeriesByID(id)
+	return cmd
+}
+
+// selectSeriesByID moves the cursor to the visible series with the given id
+func (m *Model) selectSeriesByID(id int32) {
+	for i, listItem := range m.seriesList.VisibleItems() {
+		item, _ := listItem.(sonarr.SeriesItem)
+		if item.Series != nil && item.Series.ID == id {
+			m.seriesList.Select(i)
+			return
+		}
+	}
+}
+
+// visibleSeries returns the sorted series which pass the predefined filter and the tag filter
+func (m Model) visibleSeries() []*sonarrAPI.SeriesResource {
+	all := m.client.GetSeries()
+	visible := make([]*sonarrAPI.SeriesResource, 0, len(all))
+	for _, s := range all {
+		if !m.client.Matches(m.filter, s) {
+			continue
+		}
+		if len(m.tags) > 0 && !sonarr.HasAnyTag(s, m.tags) {
+			continue
+		}
+		visible = append(visible, s)
+	}
+	sonarr.SortSeries(visible, m.sortKey, m.sortReverse)
+	return visible
+}
+
+// viewChanged updates the list after the sorting or the filter changed and persists the choice
+func (m *Model) viewChanged(message string) tea.Cmd {
+	m.appState.SonarrOverview = appstate.OverviewState{
+		SortKey:     string(m.sortKey),
+		SortReverse: m.sortReverse,
+		Filter:      string(m.filter),
+	}
+	appState := *m.appState
+	return tea.Batch(
+		m.refreshItems(),
+		statusbar.NewMessageCmd(message, statusbar.WithMessageTimeout(2)),
+		func() tea.Msg {
+			if err := appState.Save(); err != nil {
+				logging.Log.Error("Failed to save state", "err", err)
+			}
+			return nil
+		},
+	)
+}
+
+func (m Model) sortArrow() string {
+	if m.sortReverse {
+		return "↑"
+	}
+	return "↓"
+}
+
+func nextSortKey(current sonarr.SeriesSortKey) sonarr.SeriesSortKey {
+	for i, k := range sonarr.SeriesSortKeys {
+		if k == current {
+			return sonarr.SeriesSortKeys[(i+1)%len(sonarr.SeriesSortKeys)]
+		}
+	}
+	return sonarr.SeriesSortKeys[0]
+}
+
+func nextFilter(current sonarr.SeriesFilter) sonarr.SeriesFilter {
+	for i, f := range sonarr.SeriesFilters {
+		if f == current {
+			return sonarr.SeriesFilters[(i+1)%len(sonarr.SeriesFilters)]
+		}
+	}
+	return sonarr.SeriesFilters[0]
+}
+
+func (m *Model) bulkEdit() tea.Cmd {
+	selected := m.selectedSeries()
+	if len(selected) == 0 {
+		return statusbar.NewErrCmd("No series selected")
+	}
+	ids := make([]int32, len(selected))
+	for i, s := range selected {
+		ids[i] = s.ID
+	}
+	m.state = stateBulkEdit
+	m.submodel = addseries.NewBulkEdit(m.client, ids, m.Width, m.Height)
+	return m.submodel.Init()
+}
+
+func (m *Model) bulkSearch() tea.Cmd {
+	selected := m.selectedSeries()
+	if len(selected) == 0 {
+		return statusbar.NewErrCmd("No series selected")
+	}
+	ids := make([]int32, len(selected))
+	for i, s := range selected {
+		ids[i] = s.ID
+	}
+	return tea.Batch(
+		m.client.BulkSearchSeries(ids...),
+		statusbar.NewMessageCmd(fmt.Sprintf("Searching monitored episodes of %d series...", len(ids)), statusbar.WithMessageTimeout(2)),
+	)
+}
+
+func (m *Model) bulkDelete() tea.Cmd {
+	selected := m.selectedSeries()
+	if len(selected) == 0 {
+		return statusbar.NewErrCmd("No series selected")
+	}
+	m.state = stateBulkDelete
+	m.submodel = removeseries.NewBulk(m.client, selected, m.Width, m.Height)
+	return m.submodel.Init()
+}
+
+func (m *Model) bulkSeasonPass() tea.Cmd {
+	selected := m.selectedSeries()
+	if len(selected) == 0 {
+		return statusbar.NewErrCmd("No series selected")
+	}
+	m.state = stateSeasonPass
+	m.submodel = seasonpass.New(m.client, selected, m.Width, m.Height)
+	return m.submodel.Init()
+}
+
 func (m *Model) SetSize(width, height int) {
 	m.Width = width
 	m.Height = height - boxStyle.GetHorizontalFrameSize()
@@ -330,6 +813,12 @@ func (m Model) View() string {
 	case stateSeries:
 		return boxStyle.Render(m.seriesList.View())
 
+	case stateBulkEdit, stateBulkDelete, stateTagFilter, stateSeasonPass:
+		fg := m.submodel.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		return overlay.PlaceOverlay(max(x, 0), max(y, 0), fg, boxStyle.Render(m.seriesList.View()))
+
 	default:
 		if m.submodel != nil {
 			return m.submodel.View()
@@ -0,0 +1,103 @@
+package overview
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+	"github.com/jon4hz/submarr/internal/config"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/jon4hz/submarr/internal/logging"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// filterMatches runs cmd and returns the filter matches it produced.
+// Commands which don't return in time, like the blinking cursor, are ignored.
+func filterMatches(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+
+	var msg tea.Msg
+	select {
+	case msg = <-done:
+	case <-time.After(50 * time.Millisecond):
+		return nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.BatchMsg:
+		var msgs []tea.Msg
+		for _, cmd := range msg {
+			msgs = append(msgs, filterMatches(cmd)...)
+		}
+		return msgs
+	case list.FilterMatchesMsg, seriesFilterMatchesMsg:
+		return []tea.Msg{msg}
+	}
+	return nil
+}
+
+// send updates the model with msg and the filter matches resulting from it
+func send(m *Model, msg tea.Msg) {
+	_, cmd := m.Update(msg)
+	for _, msg := range filterMatches(cmd) {
+		send(m, msg)
+	}
+}
+
+func TestTagFilterSearch(t *testing.T) {
+	logging.Log = log.New(io.Discard)
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/series":
+			_, _ = w.Write([]byte(`[{"id":1,"title":"Breaking Bad","tags":[1]},{"id":2,"title":"Dark","tags":[2]}]`))
+		case "/api/v3/tag":
+			_, _ = w.Write([]byte(`[{"id":1,"label":"drama"},{"id":2,"label":"mystery"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.SonarrConfig{}
+	cfg.Host = srv.URL
+	c := sonarr.New(cfg, sonarrAPI.New(httpclient.New(), cfg))
+	require.NoError(t, c.FetchTags())
+
+	m := New(c, 80, 40).(*Model)
+	send(m, c.FetchSeries()())
+	require.Equal(t, stateSeries, m.state)
+
+	send(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	require.Equal(t, stateTagFilter, m.state)
+
+	send(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	for _, r := range "myst" {
+		send(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	// the matches reach the tag list
+	tags := m.submodel.(*tagFilter).list.VisibleItems()
+	require.Len(t, tags, 1)
+	assert.Equal(t, "mystery", tags[0].(tagItem).tag.Label)
+
+	// and the series list is left alone
+	require.Len(t, m.seriesList.VisibleItems(), 2)
+	for _, item := range m.seriesList.VisibleItems() {
+		assert.IsType(t, sonarr.SeriesItem{}, item)
+	}
+}
@@ -0,0 +1,164 @@
+package overview
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+var dialogStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder(), true).
+	BorderForeground(styles.SonarrBlue).
+	Padding(1, 2)
+
+// selectTagsMsg is sent when the tag filter was applied, an empty slice removes the filter
+type selectTagsMsg struct {
+	ids []int32
+}
+
+type tagItem struct {
+	tag       *sonarrAPI.TagResource
+	triggered bool
+}
+
+func (i tagItem) FilterValue() string { return i.tag.Label }
+
+type tagDelegate struct{}
+
+func (d tagDelegate) Height() int { return 1 }
+
+func (d tagDelegate) Spacing() int { return 0 }
+
+var itemStyles = list.NewDefaultItemStyles()
+
+func (d tagDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(tagItem)
+	if !ok {
+		return
+	}
+
+	text := fmt.Sprintf("⬜ %s", i.tag.Label)
+	if i.triggered {
+		text = fmt.Sprintf("✅ %s", i.tag.Label)
+	}
+
+	if index == m.Index() {
+		fmt.Fprint(w, itemStyles.SelectedTitle.Render(text))
+		return
+	}
+	fmt.Fprint(w, itemStyles.NormalTitle.Render(text))
+}
+
+func (d tagDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+// tagFilter lets the user choose the tags to filter the series by
+type tagFilter struct {
+	common.EmbedableModel
+
+	list list.Model
+}
+
+func newTagFilter(tags []*sonarrAPI.TagResource, selected []int32, width, height int) *tagFilter {
+	items := make([]list.Item, len(tags))
+	for i, tag := range tags {
+		var triggered bool
+		for _, id := range selected {
+			if tag.ID == id {
+				triggered = true
+				break
+			}
+		}
+		items[i] = tagItem{tag: tag, triggered: triggered}
+	}
+
+	m := tagFilter{
+		list: sonarr_list.New("Filter by tags", items, tagDelegate{}, width, height),
+	}
+	m.list.SetShowStatusBar(false)
+	m.list.FilterInput.Prompt = "Search: "
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m tagFilter) Init() tea.Cmd {
+	return statusbar.NewHelpCmd(TagFilterKeyMap.FullHelp())
+}
+
+func (m *tagFilter) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && !m.list.SettingFilter() {
+		switch {
+		case key.Matches(msg, TagFilterKeyMap.Quit):
+			m.IsQuit = true
+			return m, nil
+
+		case key.Matches(msg, TagFilterKeyMap.Back):
+			if !m.list.IsFiltered() {
+				m.IsBack = true
+				return m, nil
+			}
+
+		case key.Matches(msg, TagFilterKeyMap.Toggle):
+			item, ok := m.list.SelectedItem().(tagItem)
+			if !ok {
+				return m, nil
+			}
+			item.triggered = !item.triggered
+			// the index of the selected item is relative to the visible items
+			for i, listItem := range m.list.Items() {
+				if listItem.(tagItem).tag.ID == item.tag.ID {
+					m.list.SetItem(i, item)
+					break
+				}
+			}
+			return m, nil
+
+		case key.Matches(msg, TagFilterKeyMap.Clear):
+			for i, listItem := range m.list.Items() {
+				item := listItem.(tagItem)
+				item.triggered = false
+				m.list.SetItem(i, item)
+			}
+			return m, nil
+
+		case key.Matches(msg, TagFilterKeyMap.Apply):
+			ids := make([]int32, 0)
+			for _, listItem := range m.list.Items() {
+				if item := listItem.(tagItem); item.triggered {
+					ids = append(ids, item.tag.ID)
+				}
+			}
+			m.IsBack = true
+			return m, func() tea.Msg { return selectTagsMsg{ids: ids} }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m *tagFilter) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+	m.list.SetSize(
+		width-dialogStyle.GetHorizontalFrameSize(),
+		height-dialogStyle.GetVerticalFrameSize(),
+	)
+}
+
+func (m tagFilter) View() string {
+	if len(m.list.Items()) == 0 {
+		return dialogStyle.Render(lipgloss.NewStyle().Foreground(styles.SubtleColor).Render("No tags available"))
+	}
+	return dialogStyle.Render(m.list.View())
+}
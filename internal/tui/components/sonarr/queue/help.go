@@ -0,0 +1,46 @@
+package queue
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	NextPage   key.Binding
+	PrevPage   key.Binding
+	Quit       key.Binding
+	Back       key.Binding
+	Help       key.Binding
+	Reload     key.Binding
+	Remove     key.Binding
+	Blocklist  key.Binding
+	Grab       key.Binding
+	Import     key.Binding
+	ImportDir  key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	NextPage:   key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next page")),
+	PrevPage:   key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "prev page")),
+	Quit:       key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:       key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+	Reload:     key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
+	Remove:     key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "remove")),
+	Blocklist:  key.NewBinding(key.WithKeys("ctrl+b"), key.WithHelp("ctrl+b", "remove & blocklist")),
+	Grab:       key.NewBinding(key.WithKeys("ctrl+g"), key.WithHelp("ctrl+g", "force grab")),
+	Import:     key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "manual import")),
+	ImportDir:  key.NewBinding(key.WithKeys("I"), key.WithHelp("shift+i", "import from folder")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.NextPage, k.PrevPage},
+		{k.Reload, k.Remove, k.Blocklist, k.Grab},
+		{k.Import, k.ImportDir},
+		{k.Help, k.Back, k.Quit},
+	}
+}
@@ -0,0 +1,493 @@
+package queue
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/confirm"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/manualimport"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateQueue
+	stateConfirm
+	stateImport
+)
+
+type Model struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	state   state
+	spinner common.Spinner
+	table   table.Model
+	confirm common.SubModel
+	// manualImport is the manual import of a queue item or folder
+	manualImport common.SubModel
+
+	queue *sonarrAPI.QueueResourcePagingResource
+	pager common.Pager
+}
+
+func New(client *sonarr.Client, width, height int) *Model {
+	m := Model{
+		client:  client,
+		state:   stateLoading,
+		spinner: common.NewSpinner(),
+		table:   common.NewTable(),
+		pager:   common.NewPager(sonarr.QueuePageSize),
+	}
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Title() string {
+	return "Queue"
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.client.FetchQueue(m.pager.Page),
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.TabModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+
+		case stateQueue:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				return m, tea.Batch(
+					m.client.FetchQueue(m.pager.Page),
+					statusbar.NewMessageCmd("Reloading...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.NextPage):
+				if m.pager.NextPage() {
+					return m, m.client.FetchQueue(m.pager.Page)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.PrevPage):
+				if m.pager.PrevPage() {
+					return m, m.client.FetchQueue(m.pager.Page)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Remove):
+				if item := m.selectedItem(); item != nil {
+					return m, m.confirmRemove(item, false)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Blocklist):
+				if item := m.selectedItem(); item != nil {
+					return m, m.confirmRemove(item, true)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Grab):
+				if item := m.selectedItem(); item != nil {
+					return m, tea.Batch(
+						m.client.GrabQueueItem(item),
+						statusbar.NewMessageCmd("Grabbing release...", statusbar.WithMessageTimeout(2)),
+					)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Import):
+				if item := m.selectedItem(); item != nil {
+					if item.DownloadID == "" {
+						return m, statusbar.NewErrCmd("Only downloaded items can be imported")
+					}
+					return m, m.importFiles(seriesTitle(item), item.DownloadID)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.ImportDir):
+				return m, m.importFiles("Queue", "")
+			}
+		}
+
+	case sonarr.FetchQueueResult:
+		if m.state == stateLoading {
+			m.state = stateQueue
+		}
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch queue")
+		}
+		m.queue = msg.Queue
+		// the last page might be gone after removing items
+		if m.pager.SetTotal(int(m.queue.TotalRecords)) {
+			return m, m.client.FetchQueue(m.pager.Page)
+		}
+		m.updateTable()
+		return m, nil
+
+	case sonarr.LiveUpdateMsg:
+		if m.state != stateLoading && msg.Affects(sonarrAPI.SignalREventQueue, sonarrAPI.SignalREventQueueDetails) {
+			return m, m.client.FetchQueue(m.pager.Page)
+		}
+
+	case sonarr.RemoveQueueItemResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(fmt.Sprintf("Failed to remove %s", msg.Title))
+		}
+		message := fmt.Sprintf("Removed %s", msg.Title)
+		if msg.Blocklist {
+			message = fmt.Sprintf("Removed and blocklisted %s", msg.Title)
+		}
+		return m, tea.Batch(
+			statusbar.NewMessageCmd(message),
+			m.client.FetchQueue(m.pager.Page),
+		)
+
+	case sonarr.GrabQueueItemResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		return m, tea.Batch(
+			statusbar.NewMessageCmd(fmt.Sprintf("Grabbed %s", msg.Title)),
+			m.client.FetchQueue(m.pager.Page),
+		)
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateQueue:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+
+	case stateConfirm:
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+
+		if m.confirm.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.confirm.Back() {
+			m.state = stateQueue
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+
+	case stateImport:
+		var cmd tea.Cmd
+		m.manualImport, cmd = m.manualImport.Update(msg)
+
+		if m.manualImport.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.manualImport.Back() {
+			m.state = stateQueue
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+				m.client.FetchQueue(m.pager.Page),
+			)
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// importFiles opens the manual import of a download.
+// If downloadID is empty, the files are imported from a folder.
+func (m *Model) importFiles(title, downloadID string) tea.Cmd {
+	m.state = stateImport
+	m.manualImport = manualimport.New(m.client, title, downloadID, m.Width, m.Height)
+	return m.manualImport.Init()
+}
+
+func (m *Model) confirmRemove(item *sonarrAPI.QueueResource, blocklist bool) tea.Cmd {
+	title := "Remove from queue"
+	question := fmt.Sprintf("Remove %q from the queue and the download client?", item.Title)
+	if blocklist {
+		title = "Remove and blocklist"
+		question = fmt.Sprintf("Remove %q from the queue and the download client and add it to the blocklist?", item.Title)
+	}
+
+	m.state = stateConfirm
+	m.confirm = confirm.New(title, question, m.client.RemoveQueueItem(item, blocklist), styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.confirm.Init()
+}
+
+func (m Model) selectedItem() *sonarrAPI.QueueResource {
+	if m.queue == nil || len(m.queue.Records) == 0 {
+		return nil
+	}
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.queue.Records) {
+		return nil
+	}
+	return m.queue.Records[cursor]
+}
+
+// updateTable sets the rows and columns of the table and distributes the available height
+func (m *Model) updateTable() {
+	var records []*sonarrAPI.QueueResource
+	if m.queue != nil {
+		records = m.queue.Records
+	}
+
+	rows := make([]table.Row, 0, len(records))
+	for _, item := range records {
+		rows = append(rows, table.Row{
+			seriesTitle(item),
+			episodeNumber(item),
+			quality(item.Quality),
+			progress(item),
+			timeLeft(item),
+			State(item),
+			item.DownloadClient,
+			item.Indexer,
+		})
+	}
+
+	// the page info, the table header and the spacing take 5 lines
+	common.FitTable(&m.table, boxStyle, m.Width, m.Height, detailsHeight+5, rows, []string{"Series", "Episode", "Quality", "Progress", "Time Left", "State", "Client", "Indexer"}, 0)
+}
+
+func seriesTitle(item *sonarrAPI.QueueResource) string {
+	if item.Series == nil {
+		return item.Title
+	}
+	return item.Series.Title
+}
+
+func episodeNumber(item *sonarrAPI.QueueResource) string {
+	if item.Episode == nil {
+		return "-"
+	}
+	return fmt.Sprintf("S%02dE%02d", item.Episode.SeasonNumber, item.Episode.EpisodeNumber)
+}
+
+func quality(q *sonarrAPI.QualityModel) string {
+	if q == nil || q.Quality == nil {
+		return "Unknown"
+	}
+	return q.Quality.Name
+}
+
+func progress(item *sonarrAPI.QueueResource) string {
+	if item.Size <= 0 {
+		return "-"
+	}
+	done := (item.Size - item.Sizeleft) / item.Size * 100
+	return fmt.Sprintf("%.0f%% of %s", done, humanize.IBytes(uint64(item.Size)))
+}
+
+func timeLeft(item *sonarrAPI.QueueResource) string {
+	d := item.Timeleft.Duration()
+	if d <= 0 {
+		return "-"
+	}
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	clock := fmt.Sprintf("%02d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+	if days > 0 {
+		return fmt.Sprintf("%dd %s", days, clock)
+	}
+	return clock
+}
+
+// State returns a human readable state of a queue item
+func State(item *sonarrAPI.QueueResource) string {
+	if sonarr.IsPendingQueueItem(item) {
+		return "Pending"
+	}
+	switch item.TrackedDownloadState {
+	case sonarrAPI.Downloading:
+		if strings.EqualFold(item.Status, "paused") {
+			return "Paused"
+		}
+		return "Downloading"
+	case sonarrAPI.ImportPending:
+		return "Import pending"
+	case sonarrAPI.Importing:
+		return "Importing"
+	case sonarrAPI.Imported:
+		return "Imported"
+	case sonarrAPI.FailedPending:
+		return "Failed pending"
+	case sonarrAPI.Failed:
+		return "Failed"
+	case sonarrAPI.Ignored:
+		return "Ignored"
+	default:
+		return common.Title(item.Status)
+	}
+}
+
+// detailsHeight is the height of the details of the selected queue item
+const detailsHeight = 5
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Bold(true)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	warningStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FFD700"))
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(styles.ErrorColor)
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateQueue:
+		return m.queueView()
+	case stateConfirm:
+		fg := m.confirm.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		// make sure background fills the whole screen
+		bg := m.queueView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	case stateImport:
+		return m.manualImport.View()
+	}
+	return ":("
+}
+
+func (m Model) queueView() string {
+	var s strings.Builder
+
+	var total int32
+	if m.queue != nil {
+		total = m.queue.TotalRecords
+	}
+	s.WriteString(subtleStyle.Render(fmt.Sprintf("Page %d/%d • %d items", m.pager.Page, m.pager.TotalPages(), total)))
+	s.WriteString("\n\n")
+
+	if total == 0 {
+		s.WriteString(subtleStyle.Render("Queue is empty"))
+	} else {
+		s.WriteString(m.table.View())
+		s.WriteString("\n\n")
+		s.WriteString(m.detailsView())
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+// detailsView renders the release title and the status messages of the selected item
+func (m Model) detailsView() string {
+	item := m.selectedItem()
+	if item == nil {
+		return ""
+	}
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	lines := []string{titleStyle.Render(item.Title)}
+
+	var messages []string
+	if item.ErrorMessage != "" {
+		messages = append(messages, item.ErrorMessage)
+	}
+	for _, status := range item.StatusMessages {
+		messages = append(messages, status.Messages...)
+	}
+
+	style := subtleStyle
+	switch item.TrackedDownloadStatus {
+	case sonarrAPI.Warning:
+		style = warningStyle
+	case sonarrAPI.Error:
+		style = errorStyle
+	}
+	if len(messages) == 0 {
+		messages = append(messages, fmt.Sprintf("Status: %s", common.Title(string(item.TrackedDownloadStatus))))
+	}
+	for _, message := range messages {
+		lines = append(lines, style.Render(message))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxHeight(detailsHeight).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTable()
+
+	if m.confirm != nil {
+		m.confirm.SetSize(min(width, 60), height)
+	}
+
+	if m.manualImport != nil {
+		m.manualImport.SetSize(width, height)
+	}
+}
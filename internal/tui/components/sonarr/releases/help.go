@@ -0,0 +1,37 @@
+package releases
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	Grab       key.Binding
+	Sort       key.Binding
+	Reverse    key.Binding
+	Reload     key.Binding
+	Quit       key.Binding
+	Back       key.Binding
+	Help       key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Grab:       key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "grab")),
+	Sort:       key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort by next column")),
+	Reverse:    key.NewBinding(key.WithKeys("S"), key.WithHelp("S", "reverse sort")),
+	Reload:     key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "search again")),
+	Quit:       key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:       key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.Grab},
+		{k.Sort, k.Reverse, k.Reload},
+		{k.Help, k.Back, k.Quit},
+	}
+}
@@ -0,0 +1,451 @@
+package releases
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/confirm"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/muesli/reflow/truncate"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateReleases
+	stateConfirm
+)
+
+type column int
+
+// columnDefault keeps the order of sonarr, which ranks the releases by its preferences
+const (
+	columnDefault column = iota
+	columnTitle
+	columnIndexer
+	columnSize
+	columnAge
+	columnPeers
+	columnQuality
+	columnScore
+	columnRejections
+)
+
+var columnTitles = []string{"Title", "Indexer", "Size", "Age", "Peers", "Quality", "Score", "Rejections"}
+
+type Model struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	title   string
+	fetch   tea.Cmd
+	state   state
+	spinner common.Spinner
+	table   table.Model
+	confirm common.SubModel
+
+	// releases in the order returned by sonarr
+	original []*sonarrAPI.ReleaseResource
+	// releases in the order displayed in the table
+	releases   []*sonarrAPI.ReleaseResource
+	sortColumn column
+	sortDesc   bool
+}
+
+// New returns an interactive search for the releases returned by fetch
+func New(client *sonarr.Client, title string, fetch tea.Cmd, width, height int) common.SubModel {
+	m := Model{
+		client:  client,
+		title:   title,
+		fetch:   fetch,
+		state:   stateLoading,
+		spinner: common.NewSpinner(),
+		table:   common.NewTable(),
+	}
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		statusbar.NewMessageCmd("Searching indexers...", statusbar.WithMessageTimeout(2)),
+		m.spinner.Tick,
+		m.fetch,
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+
+		case stateReleases:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				m.state = stateLoading
+				return m, tea.Batch(
+					m.spinner.Tick,
+					m.fetch,
+					statusbar.NewMessageCmd("Searching indexers...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.Sort):
+				m.sortColumn++
+				if int(m.sortColumn) > len(columnTitles) {
+					m.sortColumn = columnDefault
+				}
+				m.sortReleases()
+				m.updateTable()
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reverse):
+				m.sortDesc = !m.sortDesc
+				m.sortReleases()
+				m.updateTable()
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Grab):
+				if release := m.selectedRelease(); release != nil {
+					return m, m.grab(release)
+				}
+				return m, nil
+			}
+		}
+
+	case sonarr.FetchReleasesResult:
+		if m.state != stateLoading {
+			return m, nil
+		}
+		m.state = stateReleases
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to search releases")
+		}
+		m.original = msg.Releases
+		m.sortReleases()
+		m.updateTable()
+		return m, nil
+
+	case sonarr.GrabReleaseResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(fmt.Sprintf("Failed to grab %s", msg.Title))
+		}
+		return m, statusbar.NewMessageCmd(fmt.Sprintf("Grabbed %s", msg.Title))
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateReleases:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+
+	case stateConfirm:
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+
+		if m.confirm.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.confirm.Back() {
+			m.state = stateReleases
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// grab downloads the release right away, rejected releases have to be confirmed first
+func (m *Model) grab(release *sonarrAPI.ReleaseResource) tea.Cmd {
+	grab := tea.Batch(
+		m.client.GrabRelease(release),
+		statusbar.NewMessageCmd("Grabbing release...", statusbar.WithMessageTimeout(2)),
+	)
+	if len(release.Rejections) == 0 {
+		return grab
+	}
+
+	question := fmt.Sprintf("%q was rejected:\n\n%s\n\nGrab it anyway?", release.Title, strings.Join(release.Rejections, "\n"))
+	m.state = stateConfirm
+	m.confirm = confirm.New("Grab release", question, grab, styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.confirm.Init()
+}
+
+func (m *Model) sortReleases() {
+	m.releases = make([]*sonarrAPI.ReleaseResource, len(m.original))
+	copy(m.releases, m.original)
+
+	less := m.less()
+	if less == nil {
+		if m.sortDesc {
+			for i, j := 0, len(m.releases)-1; i < j; i, j = i+1, j-1 {
+				m.releases[i], m.releases[j] = m.releases[j], m.releases[i]
+			}
+		}
+		return
+	}
+
+	sort.SliceStable(m.releases, func(i, j int) bool {
+		if m.sortDesc {
+			return less(m.releases[j], m.releases[i])
+		}
+		return less(m.releases[i], m.releases[j])
+	})
+}
+
+func (m Model) less() func(a, b *sonarrAPI.ReleaseResource) bool {
+	switch m.sortColumn {
+	case columnTitle:
+		return func(a, b *sonarrAPI.ReleaseResource) bool {
+			return strings.ToLower(a.Title) < strings.ToLower(b.Title)
+		}
+	case columnIndexer:
+		return func(a, b *sonarrAPI.ReleaseResource) bool {
+			return strings.ToLower(a.Indexer) < strings.ToLower(b.Indexer)
+		}
+	case columnSize:
+		return func(a, b *sonarrAPI.ReleaseResource) bool { return a.Size < b.Size }
+	case columnAge:
+		// newest first
+		return func(a, b *sonarrAPI.ReleaseResource) bool { return a.PublishDate.After(b.PublishDate) }
+	case columnPeers:
+		// most seeders first
+		return func(a, b *sonarrAPI.ReleaseResource) bool { return seeders(a) > seeders(b) }
+	case columnQuality:
+		// best quality first
+		return func(a, b *sonarrAPI.ReleaseResource) bool { return a.QualityWeight > b.QualityWeight }
+	case columnScore:
+		// highest score first
+		return func(a, b *sonarrAPI.ReleaseResource) bool { return a.CustomFormatScore > b.CustomFormatScore }
+	case columnRejections:
+		return func(a, b *sonarrAPI.ReleaseResource) bool { return len(a.Rejections) < len(b.Rejections) }
+	}
+	return nil
+}
+
+func seeders(release *sonarrAPI.ReleaseResource) int32 {
+	if release.Seeders == nil {
+		return -1
+	}
+	return *release.Seeders
+}
+
+func (m Model) selectedRelease() *sonarrAPI.ReleaseResource {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.releases) {
+		return nil
+	}
+	return m.releases[cursor]
+}
+
+// updateTable sets the rows and columns of the table and distributes the available height
+func (m *Model) updateTable() {
+	titles := make([]string, len(columnTitles))
+	copy(titles, columnTitles)
+	if m.sortColumn != columnDefault {
+		arrow := "▲"
+		if m.sortDesc {
+			arrow = "▼"
+		}
+		titles[m.sortColumn-1] += " " + arrow
+	}
+
+	rows := make([]table.Row, 0, len(m.releases))
+	for _, release := range m.releases {
+		rows = append(rows, table.Row{
+			release.Title,
+			release.Indexer,
+			humanize.IBytes(uint64(release.Size)),
+			age(release),
+			peers(release),
+			quality(release.Quality),
+			fmt.Sprintf("%+d", release.CustomFormatScore),
+			rejections(release),
+		})
+	}
+
+	// the title, the table header and the spacing take 5 lines
+	common.FitTable(&m.table, boxStyle, m.Width, m.Height, detailsHeight+5, rows, titles, 0)
+}
+
+func age(release *sonarrAPI.ReleaseResource) string {
+	switch {
+	case release.Age == 1:
+		return "1 day"
+	case release.Age > 1:
+		return fmt.Sprintf("%d days", release.Age)
+	case release.AgeHours >= 1:
+		return fmt.Sprintf("%.0f hours", release.AgeHours)
+	default:
+		return fmt.Sprintf("%.0f minutes", release.AgeMinutes)
+	}
+}
+
+func peers(release *sonarrAPI.ReleaseResource) string {
+	if release.Protocol != sonarrAPI.Torrent || release.Seeders == nil {
+		return "-"
+	}
+	var leechers int32
+	if release.Leechers != nil {
+		leechers = *release.Leechers
+	}
+	return fmt.Sprintf("%d/%d", *release.Seeders, leechers)
+}
+
+func quality(q *sonarrAPI.QualityModel) string {
+	if q == nil || q.Quality == nil {
+		return "Unknown"
+	}
+	return q.Quality.Name
+}
+
+// rejections returns the first rejection, the details show all of them
+func rejections(release *sonarrAPI.ReleaseResource) string {
+	if len(release.Rejections) == 0 {
+		return "-"
+	}
+	rejection := truncate.StringWithTail(release.Rejections[0], 30, common.Ellipsis)
+	if len(release.Rejections) > 1 {
+		rejection = fmt.Sprintf("%s (+%d)", rejection, len(release.Rejections)-1)
+	}
+	return rejection
+}
+
+// detailsHeight is the height of the details of the selected release
+const detailsHeight = 4
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Padding(0, 1).
+			Background(styles.PurpleColor)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	okStyle = lipgloss.NewStyle().
+		Foreground(styles.OkColor)
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(styles.ErrorColor)
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateReleases:
+		return m.releasesView()
+	case stateConfirm:
+		fg := m.confirm.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		// make sure background fills the whole screen
+		bg := m.releasesView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	}
+	return ":("
+}
+
+func (m Model) releasesView() string {
+	var s strings.Builder
+
+	s.WriteString(titleStyle.Render(fmt.Sprintf("%s ❯ Interactive Search", m.title)))
+	s.WriteString(subtleStyle.Render(fmt.Sprintf(" %d releases", len(m.releases))))
+	s.WriteString("\n\n")
+
+	if len(m.releases) == 0 {
+		s.WriteString(subtleStyle.Render("No results found"))
+	} else {
+		s.WriteString(m.table.View())
+		s.WriteString("\n\n")
+		s.WriteString(m.detailsView())
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+// detailsView renders the full title and the rejections of the selected release
+func (m Model) detailsView() string {
+	release := m.selectedRelease()
+	if release == nil {
+		return ""
+	}
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	lines := []string{release.Title}
+	if len(release.Rejections) == 0 {
+		lines = append(lines, okStyle.Render("Approved"))
+	}
+	for _, rejection := range release.Rejections {
+		lines = append(lines, errorStyle.Render(rejection))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxHeight(detailsHeight).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTable()
+
+	if m.confirm != nil {
+		m.confirm.SetSize(min(width, 60), height)
+	}
+}
@@ -20,7 +20,7 @@ type Model struct {
 	common.EmbedableModel
 
 	client *sonarr.Client
-	series *sonarrAPI.SeriesResource
+	series []*sonarrAPI.SeriesResource
 
 	deleteFiles  toggle.Model
 	addExclusion toggle.Model
@@ -44,6 +44,11 @@ var rmOptions = map[rmOption]string{
 }
 
 func New(client *sonarr.Client, series *sonarrAPI.SeriesResource, width, height int) common.SubModel {
+	return NewBulk(client, []*sonarrAPI.SeriesResource{series}, width, height)
+}
+
+// NewBulk returns a dialog to delete multiple series at once
+func NewBulk(client *sonarr.Client, series []*sonarrAPI.SeriesResource, width, height int) common.SubModel {
 	m := Model{
 		client:             client,
 		series:             series,
@@ -56,11 +61,24 @@ func New(client *sonarr.Client, series *sonarrAPI.SeriesResource, width, height
 	m.Width = width
 	m.Height = height
 
-	rmOptions[rmOptionDeleteFiles] = fmt.Sprintf("Delete %d files", series.Statistics.EpisodeFileCount)
+	files, _ := m.stats()
+	rmOptions[rmOptionDeleteFiles] = fmt.Sprintf("Delete %d files", files)
 
 	return &m
 }
 
+// stats returns the number of episode files and their total size of all series
+func (m Model) stats() (files int32, size int64) {
+	for _, s := range m.series {
+		if s.Statistics == nil {
+			continue
+		}
+		files += s.Statistics.EpisodeFileCount
+		size += s.Statistics.SizeOnDisk
+	}
+	return files, size
+}
+
 func getLongestOptionWidth() int {
 	var longest int
 	for _, option := range rmOptions {
@@ -126,7 +144,10 @@ func (m *Model) previousOption() {
 func (m Model) rmSeries() tea.Cmd {
 	deleteFiles := m.deleteFiles.Toggled()
 	addExclusion := m.addExclusion.Toggled()
-	return m.client.DeleteSeries(m.series, deleteFiles, addExclusion)
+	if len(m.series) == 1 {
+		return m.client.DeleteSeries(m.series[0], deleteFiles, addExclusion)
+	}
+	return m.client.BulkDeleteSeries(m.series, deleteFiles, addExclusion)
 }
 
 var (
@@ -168,8 +189,12 @@ func (m Model) View() string {
 	)
 
 	width := lipgloss.Width(options)
+	title := fmt.Sprintf("%d series", len(m.series))
+	if len(m.series) == 1 {
+		title = fmt.Sprintf("%s (%d)", m.series[0].Title, m.series[0].Year)
+	}
 	s.WriteString(
-		titleStyle.Width(width).Render(fmt.Sprintf("%s (%d)", m.series.Title, m.series.Year)),
+		titleStyle.Width(width).Render(title),
 	)
 	s.WriteString("\n\n")
 
@@ -178,12 +203,17 @@ func (m Model) View() string {
 
 	if m.deleteFiles.Toggled() {
 		errStyle := lipgloss.NewStyle().Foreground(styles.ErrorColor)
+		warning := fmt.Sprintf("The folders of %d series and all of their content will be deleted.", len(m.series))
+		if len(m.series) == 1 {
+			warning = fmt.Sprintf("The series folder %q and all of its content will be deleted.", m.series[0].Path)
+		}
+		files, size := m.stats()
 		s.WriteString(
-			errStyle.Width(width).Render(fmt.Sprintf("The series folder %q and all of its content will be deleted.", m.series.Path)),
+			errStyle.Width(width).Render(warning),
 		)
 		s.WriteByte('\n')
 		s.WriteString(
-			errStyle.Width(width).Render(fmt.Sprintf("%d episode files totaling %s", m.series.Statistics.EpisodeFileCount, humanize.IBytes(uint64(m.series.Statistics.SizeOnDisk)))),
+			errStyle.Width(width).Render(fmt.Sprintf("%d episode files totaling %s", files, humanize.IBytes(uint64(size)))),
 		)
 		s.WriteString("\n\n")
 	}
@@ -0,0 +1,382 @@
+package rename
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/confirm"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateFiles
+	stateConfirm
+)
+
+// renameStartedMsg is sent after the user confirmed the rename
+type renameStartedMsg struct {
+	count int
+}
+
+type Model struct {
+	common.EmbedableModel
+
+	client *sonarr.Client
+	title  string
+	// season is nil if the files of the whole series are previewed
+	season  *int32
+	state   state
+	spinner common.Spinner
+	table   table.Model
+	confirm common.SubModel
+
+	files []*sonarrAPI.RenameEpisodeResource
+	// selected contains the ids of the episode files to rename
+	selected common.Selection[int32]
+}
+
+// New returns a preview of the files which would be renamed.
+// If season is nil, the files of all seasons of the selected serie are shown.
+func New(client *sonarr.Client, title string, season *int32, width, height int) common.SubModel {
+	m := Model{
+		client:   client,
+		title:    title,
+		season:   season,
+		state:    stateLoading,
+		spinner:  common.NewSpinner(),
+		table:    common.NewTable(),
+		selected: make(common.Selection[int32]),
+	}
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.client.FetchRenamePreview(m.season),
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+
+		case stateFiles:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				m.state = stateLoading
+				return m, tea.Batch(
+					m.spinner.Tick,
+					m.client.FetchRenamePreview(m.season),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.Toggle):
+				if file := m.selectedFile(); file != nil {
+					m.selected.Toggle(file.EpisodeFileID)
+					m.updateTable()
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.ToggleAll):
+				ids := make([]int32, len(m.files))
+				for i, file := range m.files {
+					ids[i] = file.EpisodeFileID
+				}
+				m.selected.ToggleAll(ids)
+				m.updateTable()
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Rename):
+				return m, m.confirmRename()
+			}
+		}
+
+	case sonarr.FetchRenamePreviewResult:
+		if m.state != stateLoading {
+			return m, nil
+		}
+		m.state = stateFiles
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch the rename preview")
+		}
+		// all files are selected by default
+		m.files = msg.Files
+		m.selected.Clear()
+		for _, file := range m.files {
+			m.selected.Set(file.EpisodeFileID, true)
+		}
+		m.updateTable()
+		return m, nil
+
+	case renameStartedMsg:
+		m.IsBack = true
+		message := fmt.Sprintf("Renaming %d files...", msg.count)
+		if msg.count == 1 {
+			message = "Renaming 1 file..."
+		}
+		return m, statusbar.NewMessageCmd(message, statusbar.WithMessageTimeout(2))
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateFiles:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+
+	case stateConfirm:
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+
+		if m.confirm.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.confirm.Back() {
+			m.state = stateFiles
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// confirmRename asks the user before the selected files are renamed.
+// If all files of the series are selected, the whole series is renamed at once.
+func (m *Model) confirmRename() tea.Cmd {
+	count := m.countSelected()
+	if count == 0 {
+		return statusbar.NewErrCmd("No files selected")
+	}
+
+	var rename tea.Cmd
+	if m.season == nil && count == len(m.files) {
+		rename = m.client.RenameSeries()
+	} else {
+		ids := make([]int32, 0, count)
+		for _, file := range m.files {
+			if m.selected[file.EpisodeFileID] {
+				ids = append(ids, file.EpisodeFileID)
+			}
+		}
+		rename = m.client.RenameFiles(ids...)
+	}
+
+	question := fmt.Sprintf("Rename %d of %d files?", count, len(m.files))
+	if count == 1 {
+		question = "Rename 1 file?"
+	}
+
+	m.state = stateConfirm
+	m.confirm = confirm.New("Rename files", question, tea.Batch(
+		rename,
+		func() tea.Msg { return renameStartedMsg{count: count} },
+	), styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.confirm.Init()
+}
+
+func (m Model) countSelected() int {
+	var count int
+	for _, file := range m.files {
+		if m.selected[file.EpisodeFileID] {
+			count++
+		}
+	}
+	return count
+}
+
+func (m Model) selectedFile() *sonarrAPI.RenameEpisodeResource {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.files) {
+		return nil
+	}
+	return m.files[cursor]
+}
+
+// updateTable sets the rows and columns of the table and distributes the available height
+func (m *Model) updateTable() {
+	rows := make([]table.Row, 0, len(m.files))
+	for _, file := range m.files {
+		checkbox := "⬜"
+		if m.selected[file.EpisodeFileID] {
+			checkbox = "✅"
+		}
+		rows = append(rows, table.Row{
+			checkbox,
+			episodeNumbers(file),
+			file.ExistingPath,
+			file.NewPath,
+		})
+	}
+
+	// the title, the table header and the spacing take 5 lines
+	common.FitTable(&m.table, boxStyle, m.Width, m.Height, detailsHeight+5, rows, []string{"", "Episode", "Existing", "New"}, 3)
+}
+
+// episodeNumbers returns the episodes of the file, e.g. S01E02-E03
+func episodeNumbers(file *sonarrAPI.RenameEpisodeResource) string {
+	if len(file.EpisodeNumbers) == 0 {
+		return fmt.Sprintf("S%02d", file.SeasonNumber)
+	}
+	s := fmt.Sprintf("S%02dE%02d", file.SeasonNumber, file.EpisodeNumbers[0])
+	if len(file.EpisodeNumbers) > 1 {
+		s += fmt.Sprintf("-E%02d", file.EpisodeNumbers[len(file.EpisodeNumbers)-1])
+	}
+	return s
+}
+
+// diff highlights the part of the paths which changes, the common prefix and suffix are rendered subtle
+func diff(existing, newPath string) (string, string) {
+	a, b := []rune(existing), []rune(newPath)
+
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	var suffix int
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	render := func(path []rune, style lipgloss.Style) string {
+		return subtleStyle.Render(string(path[:prefix])) +
+			style.Render(string(path[prefix:len(path)-suffix])) +
+			subtleStyle.Render(string(path[len(path)-suffix:]))
+	}
+	return render(a, removedStyle), render(b, addedStyle)
+}
+
+// detailsHeight is the height of the details of the selected file
+const detailsHeight = 4
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Padding(0, 1).
+			Background(styles.PurpleColor)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	removedStyle = lipgloss.NewStyle().
+			Foreground(styles.ErrorColor)
+
+	addedStyle = lipgloss.NewStyle().
+			Foreground(styles.OkColor)
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateFiles:
+		return m.filesView()
+	case stateConfirm:
+		fg := m.confirm.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		// make sure background fills the whole screen
+		bg := m.filesView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	}
+	return ":("
+}
+
+func (m Model) filesView() string {
+	var s strings.Builder
+
+	s.WriteString(titleStyle.Render(fmt.Sprintf("%s ❯ Rename", m.title)))
+	s.WriteString(subtleStyle.Render(fmt.Sprintf(" %d/%d files selected", m.countSelected(), len(m.files))))
+	s.WriteString("\n\n")
+
+	if len(m.files) == 0 {
+		s.WriteString(subtleStyle.Render("All files already match the naming config"))
+	} else {
+		s.WriteString(m.table.View())
+		s.WriteString("\n\n")
+		s.WriteString(m.detailsView())
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+// detailsView renders the full existing and new path of the selected file
+func (m Model) detailsView() string {
+	file := m.selectedFile()
+	if file == nil {
+		return ""
+	}
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	// long paths are wrapped, each of them gets half of the details
+	pathStyle := lipgloss.NewStyle().
+		Width(width).
+		MaxHeight(detailsHeight / 2)
+
+	existing, newPath := diff(file.ExistingPath, file.NewPath)
+	return pathStyle.Render(existing) + "\n" + pathStyle.Render(newPath)
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTable()
+
+	if m.confirm != nil {
+		m.confirm.SetSize(min(width, 60), height)
+	}
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/muesli/reflow/truncate"
 )
 
-type Delegate struct{}
+type Delegate struct {
+	// selected holds the ids of the selected episodes
+	selected common.Selection[int32]
+}
 
 var (
 	defaultStyle = lipgloss.NewStyle().
@@ -42,7 +45,7 @@ func (d Delegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
 
 	i, ok := item.(EpisodeItem)
 	if ok {
-		season = renderItem(i, itemWidth)
+		season = renderItem(i, itemWidth, d.selected[i.episode.ID])
 	} else {
 		return
 	}
@@ -93,11 +96,15 @@ var (
 				Foreground(lipgloss.AdaptiveColor{Light: "#6B2334", Dark: "#6B2334"})
 )
 
-func renderItem(item EpisodeItem, itemWidth int) string {
+func renderItem(item EpisodeItem, itemWidth int, marked bool) string {
 	episodeTitle := fmt.Sprintf("%d. %s", item.episode.EpisodeNumber, item.episode.Title)
 
+	displayTitle := episodeTitle
+	if marked {
+		displayTitle = fmt.Sprintf("✅ %s", episodeTitle)
+	}
 	title := zone.Mark(episodeTitle,
-		truncate.StringWithTail(episodeTitle, uint(itemWidth), common.Ellipsis),
+		truncate.StringWithTail(displayTitle, uint(itemWidth), common.Ellipsis),
 	)
 
 	airDate := "---"
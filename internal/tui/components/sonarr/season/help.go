@@ -5,31 +5,44 @@ import (
 )
 
 type KeyMap struct {
-	CursorUp        key.Binding
-	CursorDown      key.Binding
-	Quit            key.Binding
-	Back            key.Binding
-	Help            key.Binding
-	Select          key.Binding
-	Reload          key.Binding
-	AutomaticSearch key.Binding
+	CursorUp          key.Binding
+	CursorDown        key.Binding
+	Quit              key.Binding
+	Back              key.Binding
+	Help              key.Binding
+	Select            key.Binding
+	Reload            key.Binding
+	AutomaticSearch   key.Binding
+	InteractiveSearch key.Binding
+	Rename            key.Binding
+	Mark              key.Binding
+	MarkRange         key.Binding
+	ToggleMonitored   key.Binding
+	UnmonitorFiles    key.Binding
 }
 
 var DefaultKeyMap = KeyMap{
-	CursorUp:        key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
-	CursorDown:      key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
-	Quit:            key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
-	Back:            key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
-	Help:            key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
-	Select:          key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
-	Reload:          key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
-	AutomaticSearch: key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "automatic search")),
+	CursorUp:          key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown:        key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Quit:              key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:              key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:              key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+	Select:            key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+	Reload:            key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
+	AutomaticSearch:   key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "automatic search")),
+	InteractiveSearch: key.NewBinding(key.WithKeys("ctrl+w"), key.WithHelp("ctrl+w", "interactive search season")),
+	Rename:            key.NewBinding(key.WithKeys("R"), key.WithHelp("shift+r", "preview rename")),
+	Mark:              key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "mark")),
+	MarkRange:         key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "mark range")),
+	ToggleMonitored:   key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "toggle monitored")),
+	UnmonitorFiles:    key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("ctrl+u", "unmonitor downloaded")),
 }
 
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.CursorUp, k.CursorDown, k.Select},
-		{k.Reload, k.AutomaticSearch},
+		{k.Mark, k.MarkRange, k.ToggleMonitored, k.UnmonitorFiles},
+		{k.Reload, k.AutomaticSearch, k.InteractiveSearch, k.Rename},
 		{k.Help, k.Back, k.Quit},
 	}
 }
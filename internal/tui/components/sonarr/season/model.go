@@ -13,6 +13,8 @@ import (
 	"github.com/jon4hz/submarr/internal/tui/common"
 	"github.com/jon4hz/submarr/internal/tui/components/sonarr/episode"
 	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/releases"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/rename"
 	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
 	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
 	zone "github.com/lrstanley/bubblezone"
@@ -24,6 +26,8 @@ const (
 	stateFetchEpisodes state = iota + 1
 	stateShowEpisodes
 	stateEpisodeDetails
+	stateReleases
+	stateRename
 )
 
 type Model struct {
@@ -34,6 +38,13 @@ type Model struct {
 	episodesList list.Model
 	spinner      common.Spinner
 	episode      common.SubModel
+	releases     common.SubModel
+	rename       common.SubModel
+
+	// selected holds the ids of the episodes marked for range monitoring
+	selected common.Selection[int32]
+	// anchor is the episode the next marked range starts from
+	anchor int32
 
 	// make sure we only reload once at a time
 	reloading bool
@@ -41,11 +52,13 @@ type Model struct {
 }
 
 func New(sonarr *sonarr.Client, width, height int) *Model {
+	selected := make(common.Selection[int32])
 	m := Model{
 		client:       sonarr,
 		state:        stateFetchEpisodes,
 		spinner:      common.NewSpinner(),
-		episodesList: sonarr_list.New(fmt.Sprintf("%s ❯ Season %d", sonarr.GetSerie().Title, sonarr.GetSeason().SeasonNumber), nil, Delegate{}, width, height),
+		episodesList: sonarr_list.New(fmt.Sprintf("%s ❯ Season %d", sonarr.GetSerie().Title, sonarr.GetSeason().SeasonNumber), nil, Delegate{selected: selected}, width, height),
+		selected:     selected,
 		mu:           &sync.Mutex{},
 	}
 
@@ -102,6 +115,38 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 					)
 				}
 
+			case key.Matches(msg, DefaultKeyMap.InteractiveSearch):
+				if !m.episodesList.SettingFilter() {
+					return m, m.interactiveSearch()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.Rename):
+				if !m.episodesList.SettingFilter() {
+					return m, m.renamePreview()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.Mark):
+				if !m.episodesList.SettingFilter() {
+					m.markEpisode()
+					return m, nil
+				}
+
+			case key.Matches(msg, DefaultKeyMap.MarkRange):
+				if !m.episodesList.SettingFilter() {
+					m.markRange()
+					return m, nil
+				}
+
+			case key.Matches(msg, DefaultKeyMap.ToggleMonitored):
+				if !m.episodesList.SettingFilter() {
+					return m, m.toggleMonitored()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.UnmonitorFiles):
+				if !m.episodesList.SettingFilter() {
+					return m, m.unmonitorDownloaded()
+				}
+
 			case key.Matches(msg, DefaultKeyMap.Select):
 				if !m.episodesList.SettingFilter() {
 					item, _ := m.episodesList.SelectedItem().(EpisodeItem)
@@ -158,9 +203,27 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 		if msg.Error != nil {
 			return m, statusbar.NewErrCmd("Error while fetching episodes!")
 		}
-		m.state = stateShowEpisodes
+		// the episodes might have been reloaded in the background while the user opened an episode
+		if m.state == stateFetchEpisodes {
+			m.state = stateShowEpisodes
+		}
 		return m, m.episodesList.SetItems(episodeToItems(msg.Episodes, m.client.GetSeriesQueue()))
 
+	case sonarr.SetEpisodesMonitoredResult:
+		if msg.Error != nil {
+			// roll back the optimistic update
+			m.applyMonitored(msg.EpisodeIDs, !msg.Monitored)
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		return m, nil
+
+	case sonarr.LiveUpdateMsg:
+		if m.state == stateShowEpisodes && !m.GetReloading() &&
+			msg.AffectsSeries(m.client.GetSerie().ID, sonarrAPI.SignalREventEpisode, sonarrAPI.SignalREventEpisodeFile, sonarrAPI.SignalREventQueue) {
+			m.SetReloading(true)
+			return m, m.client.FetchSeasonEpisodes(m.client.GetSeason().SeasonNumber)
+		}
+
 	case sonarr.EpisodeHistoryResult:
 		if msg.Error != nil {
 			return m, statusbar.NewErrCmd("Error while fetching episode history!")
@@ -189,6 +252,39 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 			return m, nil
 		}
 
+		return m, cmd
+
+	case stateReleases:
+		var cmd tea.Cmd
+		m.releases, cmd = m.releases.Update(msg)
+
+		if m.releases.Back() {
+			m.state = stateShowEpisodes
+			return m, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+		}
+		if m.releases.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		return m, cmd
+
+	case stateRename:
+		var cmd tea.Cmd
+		m.rename, cmd = m.rename.Update(msg)
+
+		if m.rename.Back() {
+			m.state = stateShowEpisodes
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+		if m.rename.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
 		return m, cmd
 	}
 
@@ -207,10 +303,147 @@ func (m *Model) SetReloading(reloading bool) {
 	m.reloading = reloading
 }
 
+// markEpisode toggles the mark of the selected episode
+func (m *Model) markEpisode() {
+	item, _ := m.episodesList.SelectedItem().(EpisodeItem)
+	if item.episode == nil {
+		return
+	}
+	m.selected.Toggle(item.episode.ID)
+	m.anchor = item.episode.ID
+}
+
+// markRange marks all episodes between the last marked episode and the selected one
+func (m *Model) markRange() {
+	item, _ := m.episodesList.SelectedItem().(EpisodeItem)
+	if item.episode == nil {
+		return
+	}
+	items := m.episodesList.VisibleItems()
+	start := -1
+	for i, listItem := range items {
+		if listItem.(EpisodeItem).episode.ID == m.anchor {
+			start = i
+			break
+		}
+	}
+	// without an anchor, this behaves like a single mark
+	if start == -1 || !m.selected[m.anchor] {
+		m.markEpisode()
+		return
+	}
+	end := m.episodesList.Index()
+	for i := min(start, end); i <= max(start, end); i++ {
+		m.selected.Set(items[i].(EpisodeItem).episode.ID, true)
+	}
+	m.anchor = item.episode.ID
+}
+
+// toggleMonitored toggles the selected episode or, if episodes are marked, monitors all of them.
+// If all marked episodes are monitored already, they are unmonitored instead.
+func (m *Model) toggleMonitored() tea.Cmd {
+	var episodes []*sonarrAPI.EpisodeResource
+	for _, listItem := range m.episodesList.Items() {
+		item := listItem.(EpisodeItem)
+		if m.selected[item.episode.ID] {
+			episodes = append(episodes, item.episode)
+		}
+	}
+	if len(episodes) == 0 {
+		item, _ := m.episodesList.SelectedItem().(EpisodeItem)
+		if item.episode == nil {
+			return nil
+		}
+		episodes = append(episodes, item.episode)
+	}
+
+	var monitored bool
+	for _, episode := range episodes {
+		if !episode.Monitored {
+			monitored = true
+			break
+		}
+	}
+	return m.setMonitored(episodes, monitored)
+}
+
+// unmonitorDownloaded unmonitors all episodes of the season which are downloaded already
+func (m *Model) unmonitorDownloaded() tea.Cmd {
+	var episodes []*sonarrAPI.EpisodeResource
+	for _, listItem := range m.episodesList.Items() {
+		item := listItem.(EpisodeItem)
+		if item.episode.EpisodeFile != nil {
+			episodes = append(episodes, item.episode)
+		}
+	}
+	return m.setMonitored(episodes, false)
+}
+
+// setMonitored updates the episodes optimistically and sends a single request for all episodes which change.
+func (m *Model) setMonitored(episodes []*sonarrAPI.EpisodeResource, monitored bool) tea.Cmd {
+	ids := make([]int32, 0, len(episodes))
+	for _, episode := range episodes {
+		// only send the episodes which actually change, so a rollback restores the previous state
+		if episode.Monitored != monitored {
+			ids = append(ids, episode.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return statusbar.NewMessageCmd("Nothing to change", statusbar.WithMessageTimeout(2))
+	}
+
+	m.applyMonitored(ids, monitored)
+	m.selected.Clear()
+
+	action := "Unmonitoring"
+	if monitored {
+		action = "Monitoring"
+	}
+	message := fmt.Sprintf("%s %d episodes...", action, len(ids))
+	if len(ids) == 1 {
+		message = fmt.Sprintf("%s 1 episode...", action)
+	}
+	return tea.Batch(
+		m.client.SetEpisodesMonitored(ids, monitored),
+		statusbar.NewMessageCmd(message, statusbar.WithMessageTimeout(2)),
+	)
+}
+
+// applyMonitored sets the monitored state of the listed episodes.
+// The episodes are looked up by id, because they might have been reloaded in the meantime.
+func (m *Model) applyMonitored(ids []int32, monitored bool) {
+	set := make(map[int32]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	for _, listItem := range m.episodesList.Items() {
+		item := listItem.(EpisodeItem)
+		if set[item.episode.ID] {
+			item.episode.Monitored = monitored
+		}
+	}
+}
+
 func (m *Model) selectEpisode(episode *sonarrAPI.EpisodeResource) tea.Cmd {
 	return m.client.GetEpisodeHistory(episode)
 }
 
+func (m *Model) interactiveSearch() tea.Cmd {
+	season := m.client.GetSeason()
+	m.state = stateReleases
+	title := fmt.Sprintf("%s ❯ Season %d", m.client.GetSerie().Title, season.SeasonNumber)
+	m.releases = releases.New(m.client, title, m.client.FetchSeasonReleases(season.SeasonNumber), m.Width, m.Height+boxStyle.GetVerticalFrameSize())
+	return m.releases.Init()
+}
+
+func (m *Model) renamePreview() tea.Cmd {
+	season := m.client.GetSeason()
+	m.state = stateRename
+	title := fmt.Sprintf("%s ❯ Season %d", m.client.GetSerie().Title, season.SeasonNumber)
+	m.rename = rename.New(m.client, title, &season.SeasonNumber, m.Width, m.Height+boxStyle.GetVerticalFrameSize())
+	return m.rename.Init()
+}
+
 func (m *Model) SetSize(width, height int) {
 	width -= boxStyle.GetHorizontalFrameSize()
 	height -= boxStyle.GetVerticalFrameSize()
@@ -223,6 +456,14 @@ func (m *Model) SetSize(width, height int) {
 	if m.episode != nil {
 		m.episode.SetSize(width, height+boxStyle.GetVerticalFrameSize())
 	}
+
+	if m.releases != nil {
+		m.releases.SetSize(width, height+boxStyle.GetVerticalFrameSize())
+	}
+
+	if m.rename != nil {
+		m.rename.SetSize(width, height+boxStyle.GetVerticalFrameSize())
+	}
 }
 
 var boxStyle = lipgloss.NewStyle().
@@ -238,6 +479,12 @@ func (m *Model) View() string {
 
 	case stateEpisodeDetails:
 		return m.episode.View()
+
+	case stateReleases:
+		return m.releases.View()
+
+	case stateRename:
+		return m.rename.View()
 	}
 
 	return ""
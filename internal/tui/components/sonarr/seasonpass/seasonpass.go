@@ -0,0 +1,361 @@
+package seasonpass
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/muesli/reflow/truncate"
+)
+
+type step int
+
+const (
+	stepMonitor step = iota + 1
+	stepLoading
+	stepPreview
+)
+
+// maxWidth is the maximal width of the dialog
+const maxWidth = 80
+
+// headerHeight is the height of the title and the summary above the preview
+const headerHeight = 4
+
+// listFrameHeight is the height of the title and the pagination of the list
+const listFrameHeight = 3
+
+var descriptions = map[sonarrAPI.MonitorType]string{
+	sonarrAPI.All:          "Monitor all episodes except specials",
+	sonarrAPI.Future:       "Monitor episodes that have not aired yet",
+	sonarrAPI.Missing:      "Monitor episodes that do not have files",
+	sonarrAPI.Existing:     "Monitor episodes that have files or have not aired yet",
+	sonarrAPI.Pilot:        "Only monitor the first episode of the first season",
+	sonarrAPI.FirstSeason:  "Monitor all episodes of the first season",
+	sonarrAPI.LatestSeason: "Monitor the latest season, unless it ended over 90 days ago",
+	sonarrAPI.None:         "Unmonitor all episodes and the series",
+}
+
+type monitorItem struct {
+	monitor sonarrAPI.MonitorType
+}
+
+func (i monitorItem) FilterValue() string { return string(i.monitor) }
+
+type monitorDelegate struct{}
+
+func (d monitorDelegate) Height() int { return 2 }
+
+func (d monitorDelegate) Spacing() int { return 0 }
+
+var itemStyles = list.NewDefaultItemStyles()
+
+func (d monitorDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(monitorItem)
+	if !ok {
+		return
+	}
+
+	width := uint(max(m.Width()-itemStyles.NormalTitle.GetHorizontalFrameSize(), 0))
+	title := truncate.StringWithTail(string(i.monitor), width, common.Ellipsis)
+	desc := truncate.StringWithTail(descriptions[i.monitor], width, common.Ellipsis)
+
+	if index == m.Index() {
+		fmt.Fprintf(w, "%s\n%s", itemStyles.SelectedTitle.Render(title), itemStyles.SelectedDesc.Render(desc))
+		return
+	}
+	fmt.Fprintf(w, "%s\n%s", itemStyles.NormalTitle.Render(title), itemStyles.NormalDesc.Render(desc))
+}
+
+func (d monitorDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+// Model applies a monitor type to one or multiple existing series.
+// The changes are previewed before they are applied.
+type Model struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	series  []*sonarrAPI.SeriesResource
+	step    step
+	list    list.Model
+	spinner common.Spinner
+	preview viewport.Model
+
+	monitor  sonarrAPI.MonitorType
+	previews []*sonarr.SeasonPassPreview
+}
+
+// New returns a dialog to change the monitoring of the given series
+func New(client *sonarr.Client, series []*sonarrAPI.SeriesResource, width, height int) common.SubModel {
+	items := make([]list.Item, len(sonarr.SeasonPassMonitorTypes))
+	for i, monitor := range sonarr.SeasonPassMonitorTypes {
+		items[i] = monitorItem{monitor: monitor}
+	}
+
+	m := Model{
+		client:  client,
+		series:  series,
+		step:    stepMonitor,
+		list:    sonarr_list.New("", items, monitorDelegate{}, width, height),
+		spinner: common.NewSpinner(),
+		preview: viewport.New(0, 0),
+	}
+	m.list.Title = fmt.Sprintf("%s ❯ Monitoring", m.title())
+	m.list.SetShowStatusBar(false)
+	m.list.SetFilteringEnabled(false)
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Init() tea.Cmd {
+	return statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+}
+
+func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, DefaultKeyMap.Quit):
+			m.IsQuit = true
+			return m, nil
+
+		case key.Matches(msg, DefaultKeyMap.Back):
+			if m.step == stepMonitor {
+				m.IsBack = true
+				return m, nil
+			}
+			m.step = stepMonitor
+			return m, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+
+		case key.Matches(msg, DefaultKeyMap.Select):
+			switch m.step {
+			case stepMonitor:
+				item, ok := m.list.SelectedItem().(monitorItem)
+				if !ok {
+					return m, nil
+				}
+				m.step = stepLoading
+				m.monitor = item.monitor
+				return m, tea.Batch(
+					m.spinner.Tick,
+					m.client.FetchSeasonPassPreview(m.series, m.monitor),
+				)
+
+			case stepPreview:
+				return m, m.apply()
+			}
+		}
+
+	case sonarr.SeasonPassPreviewResult:
+		// the user might have gone back while the preview was loading
+		if m.step != stepLoading || msg.Monitor != m.monitor {
+			return m, nil
+		}
+		if msg.Error != nil {
+			m.step = stepMonitor
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		m.step = stepPreview
+		m.previews = msg.Previews
+		m.setPreviewContent()
+		m.preview.GotoTop()
+		return m, statusbar.NewHelpCmd(PreviewKeyMap.FullHelp())
+	}
+
+	switch m.step {
+	case stepMonitor:
+		var cmd tea.Cmd
+		m.list, cmd = m.list.Update(msg)
+		return m, cmd
+
+	case stepLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stepPreview:
+		var cmd tea.Cmd
+		m.preview, cmd = m.preview.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// apply closes the dialog and applies the monitor type to all series
+func (m *Model) apply() tea.Cmd {
+	var changed bool
+	for _, p := range m.previews {
+		if p.Changed() {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return statusbar.NewErrCmd("Nothing to change")
+	}
+
+	ids := make([]int32, len(m.series))
+	for i, s := range m.series {
+		ids[i] = s.ID
+	}
+	m.IsBack = true
+	return tea.Batch(
+		m.client.SeasonPass(ids, m.monitor),
+		statusbar.NewMessageCmd(fmt.Sprintf("Changing monitoring of %s to %s...", m.title(), m.monitor), statusbar.WithMessageTimeout(2)),
+	)
+}
+
+func (m Model) title() string {
+	if len(m.series) == 1 {
+		return m.series[0].Title
+	}
+	return fmt.Sprintf("%d series", len(m.series))
+}
+
+// summary counts the seasons and episodes which get monitored and unmonitored
+func (m Model) summary() string {
+	var seasons, episodes [2]int
+	for _, p := range m.previews {
+		for _, s := range p.Seasons {
+			seasons[boolIndex(s.Monitored)]++
+		}
+		for _, e := range p.Episodes {
+			episodes[boolIndex(e.Monitored)]++
+		}
+	}
+	return fmt.Sprintf("Monitor: %d seasons, %d episodes • Unmonitor: %d seasons, %d episodes",
+		seasons[1], episodes[1], seasons[0], episodes[0],
+	)
+}
+
+func boolIndex(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (m Model) previewContent() string {
+	var s strings.Builder
+	for i, p := range m.previews {
+		if len(m.previews) > 1 {
+			if i > 0 {
+				s.WriteByte('\n')
+			}
+			s.WriteString(seriesStyle.Render(p.Series.Title))
+			s.WriteByte('\n')
+		}
+		if !p.Changed() {
+			s.WriteString(subtleStyle.Render("Nothing changes"))
+			s.WriteByte('\n')
+			continue
+		}
+		if p.UnmonitorSeries {
+			s.WriteString(change(false, "Series"))
+			s.WriteByte('\n')
+		}
+		for _, season := range p.Seasons {
+			name := fmt.Sprintf("Season %d", season.SeasonNumber)
+			if season.SeasonNumber == 0 {
+				name = "Specials"
+			}
+			s.WriteString(change(season.Monitored, name))
+			s.WriteByte('\n')
+		}
+		for _, e := range p.Episodes {
+			name := fmt.Sprintf("S%02dE%02d %s", e.Episode.SeasonNumber, e.Episode.EpisodeNumber, e.Episode.Title)
+			s.WriteString(change(e.Monitored, truncate.StringWithTail(name, uint(max(m.preview.Width-2, 0)), common.Ellipsis)))
+			s.WriteByte('\n')
+		}
+	}
+	return strings.TrimSuffix(s.String(), "\n")
+}
+
+// change renders a line of the preview, prefixed depending on whether the item gets monitored
+func change(monitored bool, text string) string {
+	if monitored {
+		return addedStyle.Render("+ " + text)
+	}
+	return removedStyle.Render("- " + text)
+}
+
+func (m *Model) SetSize(width, height int) {
+	width = min(width, maxWidth)
+	m.Width = width
+	m.Height = height
+
+	width -= dialogStyle.GetHorizontalFrameSize()
+	height -= dialogStyle.GetVerticalFrameSize()
+	// the dialog shouldn't be higher than its content
+	m.list.SetSize(width, min(height, len(m.list.Items())*monitorDelegate{}.Height()+listFrameHeight))
+	m.preview.Width = max(width, 0)
+	m.setPreviewContent()
+}
+
+// setPreviewContent renders the preview and shrinks the viewport to the content
+func (m *Model) setPreviewContent() {
+	content := m.previewContent()
+	available := max(m.Height-dialogStyle.GetVerticalFrameSize()-headerHeight, 0)
+	m.preview.Height = min(available, lipgloss.Height(content))
+	m.preview.SetContent(content)
+}
+
+var (
+	dialogStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			BorderForeground(styles.SonarrBlue).
+			Padding(1, 2)
+
+	titleStyle = lipgloss.NewStyle().
+			Padding(0, 1).
+			Background(styles.PurpleColor)
+
+	seriesStyle = lipgloss.NewStyle().
+			Bold(true)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	removedStyle = lipgloss.NewStyle().
+			Foreground(styles.ErrorColor)
+
+	addedStyle = lipgloss.NewStyle().
+			Foreground(styles.OkColor)
+)
+
+func (m Model) View() string {
+	switch m.step {
+	case stepLoading:
+		return m.dialog(m.spinner.View() + " Calculating changes...")
+
+	case stepPreview:
+		width := m.preview.Width
+		return m.dialog(lipgloss.JoinVertical(lipgloss.Left,
+			titleStyle.Render(truncate.StringWithTail(fmt.Sprintf("%s ❯ %s", m.title(), m.monitor), uint(max(width-2, 0)), common.Ellipsis)),
+			"",
+			subtleStyle.Render(truncate.StringWithTail(m.summary(), uint(width), common.Ellipsis)),
+			"",
+			m.preview.View(),
+		))
+	}
+
+	return m.dialog(m.list.View())
+}
+
+// dialog renders the content with the same width in every step
+func (m Model) dialog(content string) string {
+	return dialogStyle.Width(max(m.Width-dialogStyle.GetHorizontalBorderSize(), 0)).Render(content)
+}
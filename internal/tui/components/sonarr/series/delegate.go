@@ -3,6 +3,7 @@ package series
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -16,7 +17,12 @@ import (
 	"github.com/muesli/reflow/truncate"
 )
 
-type Delegate struct{}
+type Delegate struct {
+	// Selected contains the ids of the series which are selected for a bulk action
+	Selected common.Selection[int32]
+	// Client is used to resolve the labels of the tags, tags are hidden if it's nil
+	Client *sonarr.Client
+}
 
 var (
 	DefaultStyle = lipgloss.NewStyle().
@@ -44,7 +50,11 @@ func (d Delegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
 
 	i, ok := item.(sonarr.SeriesItem)
 	if ok {
-		serie = renderItem(i, itemWidth, index == m.Index())
+		var tags []string
+		if d.Client != nil {
+			tags = d.Client.TagLabels(i.Series.Tags)
+		}
+		serie = renderItem(i, itemWidth, index == m.Index(), d.Selected[i.Series.ID], tags)
 	} else {
 		return
 	}
@@ -77,13 +87,16 @@ var (
 			Render("•")
 )
 
-func renderItem(item sonarr.SeriesItem, itemWidth int, isSelected bool) string {
+func renderItem(item sonarr.SeriesItem, itemWidth int, isSelected, isMarked bool, tags []string) string {
 	textColor := SelectedForeground
 	if !isSelected {
 		textColor = styles.SubtleColor
 	}
 
 	title := TitleStyle.Foreground(textColor).Render(item.Series.Title)
+	if isMarked {
+		title = "✅ " + title
+	}
 	title = zone.Mark(item.Series.Title,
 		truncate.StringWithTail(title, uint(itemWidth), common.Ellipsis),
 	)
@@ -111,6 +124,13 @@ func renderItem(item sonarr.SeriesItem, itemWidth int, isSelected bool) string {
 		Separator,
 		lipgloss.NewStyle().Foreground(textColor).Render(profile),
 	)
+	if len(tags) > 0 {
+		profileStats = lipgloss.JoinHorizontal(lipgloss.Top,
+			profileStats,
+			Separator,
+			lipgloss.NewStyle().Foreground(styles.SonarrBlue).Render("#"+strings.Join(tags, " #")),
+		)
+	}
 	profileStats = truncate.StringWithTail(profileStats, uint(itemWidth), common.Ellipsis)
 
 	network := item.Series.Network
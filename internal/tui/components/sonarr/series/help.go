@@ -21,6 +21,10 @@ type KeyMap struct {
 	AutomaticSearchAll  key.Binding
 	InteractiveSearch   key.Binding
 	Delete              key.Binding
+	Edit                key.Binding
+	Rename              key.Binding
+	Files               key.Binding
+	SeasonPass          key.Binding
 }
 
 var DefaultKeyMap = KeyMap{
@@ -40,13 +44,18 @@ var DefaultKeyMap = KeyMap{
 	AutomaticSearchAll:  key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "search all seasons")),
 	InteractiveSearch:   key.NewBinding(key.WithKeys("ctrl+w"), key.WithHelp("ctrl+w", "interactive search season")),
 	Delete:              key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "delete series")),
+	Edit:                key.NewBinding(key.WithKeys("ctrl+e"), key.WithHelp("ctrl+e", "edit series")),
+	Rename:              key.NewBinding(key.WithKeys("R"), key.WithHelp("shift+r", "preview rename")),
+	Files:               key.NewBinding(key.WithKeys("ctrl+f"), key.WithHelp("ctrl+f", "episode files")),
+	SeasonPass:          key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "change monitoring")),
 }
 
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Tab, k.CursorUp, k.CursorDown, k.Select},
-		{k.Reload, k.ToggleMonitorSeries, k.ToggleMonitor, k.Refresh},
-		{k.AutomaticSearchAll, k.AutomaticSearch, k.InteractiveSearch, k.Delete},
+		{k.Reload, k.ToggleMonitorSeries, k.ToggleMonitor, k.SeasonPass, k.Refresh},
+		{k.AutomaticSearchAll, k.AutomaticSearch, k.InteractiveSearch},
+		{k.Edit, k.Files, k.Rename, k.Delete},
 		{k.Help, k.Back, k.Quit},
 	}
 }
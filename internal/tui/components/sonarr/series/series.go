@@ -14,8 +14,13 @@ import (
 	"github.com/jon4hz/stickers/flexbox"
 	"github.com/jon4hz/submarr/internal/core/sonarr"
 	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/addseries"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/episodefiles"
 	sonarr_list "github.com/jon4hz/submarr/internal/tui/components/sonarr/list"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/releases"
 	"github.com/jon4hz/submarr/internal/tui/components/sonarr/removeseries"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/rename"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/seasonpass"
 	"github.com/jon4hz/submarr/internal/tui/components/sonarr/seasons"
 	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
 	"github.com/jon4hz/submarr/internal/tui/overlay"
@@ -44,6 +49,11 @@ type state int
 const (
 	stateSeries state = iota + 1
 	stateDelete
+	stateReleases
+	stateEdit
+	stateRename
+	stateFiles
+	stateSeasonPass
 )
 
 type Model struct {
@@ -60,6 +70,11 @@ type Model struct {
 	seasonsList   list.Model
 	state         state
 	delete        common.SubModel
+	releases      common.SubModel
+	edit          common.SubModel
+	rename        common.SubModel
+	files         common.SubModel
+	seasonPass    common.SubModel
 }
 
 var (
@@ -188,6 +203,11 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 					)
 				}
 
+			case key.Matches(msg, DefaultKeyMap.SeasonPass):
+				if !m.seasonsList.SettingFilter() {
+					return m, m.changeMonitoring()
+				}
+
 			case key.Matches(msg, DefaultKeyMap.Select):
 				if !m.seasonsList.SettingFilter() {
 					item := m.seasonsList.SelectedItem().(seasons.SeasonItem)
@@ -209,6 +229,12 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 					)
 				}
 
+			case key.Matches(msg, DefaultKeyMap.InteractiveSearch):
+				if !m.seasonsList.SettingFilter() {
+					season := m.seasonsList.SelectedItem().(seasons.SeasonItem)
+					return m, m.interactiveSearch(season.Season.SeasonNumber)
+				}
+
 			case key.Matches(msg, DefaultKeyMap.AutomaticSearchAll):
 				return m, tea.Batch(
 					m.client.AutomaticSearchSeries(),
@@ -217,9 +243,29 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 
 			case key.Matches(msg, DefaultKeyMap.Delete):
 				return m, m.deleteSeries()
+
+			case key.Matches(msg, DefaultKeyMap.Edit):
+				if !m.seasonsList.SettingFilter() {
+					return m, m.editSeries()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.Rename):
+				if !m.seasonsList.SettingFilter() {
+					return m, m.renamePreview()
+				}
+
+			case key.Matches(msg, DefaultKeyMap.Files):
+				if !m.seasonsList.SettingFilter() {
+					return m, m.episodeFiles()
+				}
 			}
 		}
 
+	case sonarr.LiveUpdateMsg:
+		if m.state == stateSeries && msg.AffectsSeries(m.client.GetSerie().ID, sonarrAPI.SignalREventSeries, sonarrAPI.SignalREventEpisode, sonarrAPI.SignalREventEpisodeFile) {
+			return m, m.client.ReloadSerie()
+		}
+
 	case sonarr.FetchSerieResult:
 		m.seasonsList.StopSpinner()
 		if msg.Error != nil {
@@ -228,6 +274,26 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 		m.updateStatsViewport()
 		return m, m.seasonsList.SetItems(newSeasonsItems(msg.Serie))
 
+	case sonarr.EditSeriesResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		m.updateStatsViewport()
+		return m, tea.Batch(
+			m.seasonsList.SetItems(newSeasonsItems(msg.Serie)),
+			statusbar.NewMessageCmd(fmt.Sprintf("Saved %s", msg.Serie.Title), statusbar.WithMessageTimeout(3)),
+		)
+
+	case sonarr.SeasonPassResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		return m, tea.Batch(
+			m.client.ReloadSerie(),
+			m.seasonsList.StartSpinner(),
+			statusbar.NewMessageCmd(fmt.Sprintf("Changed monitoring to %s", msg.Monitor), statusbar.WithMessageTimeout(3)),
+		)
+
 	case tea.MouseMsg:
 		switch m.state {
 		case stateSeries:
@@ -293,6 +359,99 @@ func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
 			return m, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
 		}
 
+		return m, cmd
+
+	case stateReleases:
+		var cmd tea.Cmd
+		m.releases, cmd = m.releases.Update(msg)
+
+		if m.releases.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.releases.Back() {
+			m.state = stateSeries
+			return m, statusbar.NewHelpCmd(DefaultKeyMap.FullHelp())
+		}
+
+		return m, cmd
+
+	case stateEdit:
+		var cmd tea.Cmd
+		m.edit, cmd = m.edit.Update(msg)
+
+		if m.edit.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.edit.Back() {
+			m.state = stateSeries
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+
+	case stateFiles:
+		var cmd tea.Cmd
+		m.files, cmd = m.files.Update(msg)
+
+		if m.files.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.files.Back() {
+			m.state = stateSeries
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+				m.client.ReloadSerie(),
+			)
+		}
+
+		return m, cmd
+
+	case stateRename:
+		var cmd tea.Cmd
+		m.rename, cmd = m.rename.Update(msg)
+
+		if m.rename.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.rename.Back() {
+			m.state = stateSeries
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+
+	case stateSeasonPass:
+		var cmd tea.Cmd
+		m.seasonPass, cmd = m.seasonPass.Update(msg)
+
+		if m.seasonPass.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.seasonPass.Back() {
+			m.state = stateSeries
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
 		return m, cmd
 	}
 	return m, nil
@@ -304,6 +463,37 @@ func (m *Model) deleteSeries() tea.Cmd {
 	return m.delete.Init()
 }
 
+func (m *Model) editSeries() tea.Cmd {
+	m.state = stateEdit
+	m.edit = addseries.NewEdit(m.client, m.client.GetSerie(), m.Width, m.Height)
+	return m.edit.Init()
+}
+
+func (m *Model) changeMonitoring() tea.Cmd {
+	m.state = stateSeasonPass
+	m.seasonPass = seasonpass.New(m.client, []*sonarrAPI.SeriesResource{m.client.GetSerie()}, m.Width, m.Height)
+	return m.seasonPass.Init()
+}
+
+func (m *Model) episodeFiles() tea.Cmd {
+	m.state = stateFiles
+	m.files = episodefiles.New(m.client, m.Width, m.Height)
+	return m.files.Init()
+}
+
+func (m *Model) renamePreview() tea.Cmd {
+	m.state = stateRename
+	m.rename = rename.New(m.client, m.client.GetSerie().Title, nil, m.Width, m.Height)
+	return m.rename.Init()
+}
+
+func (m *Model) interactiveSearch(seasonNumber int32) tea.Cmd {
+	m.state = stateReleases
+	title := fmt.Sprintf("%s ❯ Season %d", m.client.GetSerie().Title, seasonNumber)
+	m.releases = releases.New(m.client, title, m.client.FetchSeasonReleases(seasonNumber), m.Width, m.Height)
+	return m.releases.Init()
+}
+
 func (m *Model) SetSize(width, height int) {
 	m.Width = width
 	m.Height = height
@@ -327,6 +517,26 @@ func (m *Model) SetSize(width, height int) {
 	if m.state == stateDelete {
 		m.delete.SetSize(width, height)
 	}
+
+	if m.state == stateReleases {
+		m.releases.SetSize(width, height)
+	}
+
+	if m.state == stateEdit {
+		m.edit.SetSize(width, height)
+	}
+
+	if m.state == stateRename {
+		m.rename.SetSize(width, height)
+	}
+
+	if m.state == stateFiles {
+		m.files.SetSize(width, height)
+	}
+
+	if m.state == stateSeasonPass {
+		m.seasonPass.SetSize(width, height)
+	}
 }
 
 func (m *Model) focusNext() {
@@ -513,14 +723,31 @@ func (m Model) View() string {
 		m.redraw()
 		return m.flexBox.Render()
 
-	case stateDelete:
+	case stateDelete, stateEdit, stateSeasonPass:
 		m.redraw()
-		fg := m.delete.View()
+		var fg string
+		switch m.state {
+		case stateDelete:
+			fg = m.delete.View()
+		case stateEdit:
+			fg = m.edit.View()
+		case stateSeasonPass:
+			fg = m.seasonPass.View()
+		}
 		x := ((m.Width - lipgloss.Width(fg)) / 2)
 		y := ((m.Height - lipgloss.Height(fg)) / 2)
 		// make sure background fills the whole screen
 		bg := lipgloss.NewStyle().Width(m.Width).Height(m.Height).Render(m.flexBox.Render())
 		return overlay.PlaceOverlay(x, y, fg, bg)
+
+	case stateReleases:
+		return m.releases.View()
+
+	case stateRename:
+		return m.rename.View()
+
+	case stateFiles:
+		return m.files.View()
 	}
 
 	return ":("
@@ -1,11 +1,22 @@
 package sonarr
 
 import (
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/jon4hz/submarr/internal/core/sonarr"
 	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/blocklist"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/calendar"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/history"
 	"github.com/jon4hz/submarr/internal/tui/components/sonarr/overview"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/queue"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/tags"
+	"github.com/jon4hz/submarr/internal/tui/components/sonarr/wanted"
 	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/components/system"
+	"github.com/jon4hz/submarr/internal/tui/components/tabs"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
 	"github.com/jon4hz/submarr/internal/tui/styles"
 )
 
@@ -15,12 +26,27 @@ type Model struct {
 	client *sonarr.Client
 
 	submodel common.SubModel
+
+	// tasks holds the commands which are tracked until they are finished
+	tasks     *tasks
+	showTasks bool
 }
 
 func New(c *sonarr.Client, width, height int) *Model {
 	m := Model{
-		client:   c,
-		submodel: overview.New(c, width, height),
+		client: c,
+		tasks:  newTasks(),
+		submodel: tabs.New(styles.SonarrBlue, width, height,
+			overview.New(c, width, height),
+			queue.New(c, width, height),
+			calendar.New(c, width, height),
+			wanted.New(c, sonarr.WantedMissing, width, height),
+			wanted.New(c, sonarr.WantedCutoffUnmet, width, height),
+			history.New(c, width, height),
+			blocklist.New(c, width, height),
+			tags.New(c, width, height),
+			system.NewSonarr(c, width, height),
+		),
 	}
 
 	m.Width = width
@@ -30,29 +56,76 @@ func New(c *sonarr.Client, width, height int) *Model {
 }
 
 func (m Model) Init() tea.Cmd {
-	return tea.Batch(
+	cmds := []tea.Cmd{
 		statusbar.NewTitleCmd("Sonarr", statusbar.WithTitleForeground(styles.SonarrBlue)),
 		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
 		m.submodel.Init(),
-	)
+	}
+	if m.client.Config.LiveUpdates {
+		cmds = append(cmds, m.client.StartLiveUpdates())
+	}
+	return tea.Batch(cmds...)
 }
 
 func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, DefaultKeyMap.Tasks) {
+			m.showTasks = !m.showTasks
+			return m, nil
+		}
+
+	case sonarr.CommandUpdateMsg:
+		return m, m.tasks.update(m.client, msg)
+
+	case sonarr.LiveStatusMsg:
+		message := "Live updates connected"
+		if !msg.Connected {
+			message = "Live updates disconnected, polling..."
+		}
+		return m, tea.Batch(
+			m.client.WaitForLiveUpdate(),
+			m.tasks.setConnected(m.client, msg.Connected),
+			statusbar.NewMessageCmd(message, statusbar.WithMessageTimeout(2)),
+		)
+
+	case sonarr.LiveUpdateMsg:
+		cmds := []tea.Cmd{m.client.WaitForLiveUpdate()}
+		for _, command := range msg.Commands() {
+			cmds = append(cmds, m.tasks.live(command))
+		}
+		var cmd tea.Cmd
+		m.submodel, cmd = m.submodel.Update(msg)
+		cmds = append(cmds, cmd)
+		return m, tea.Batch(cmds...)
+	}
+
 	var cmd tea.Cmd
 	m.submodel, cmd = m.submodel.Update(msg)
 	if m.submodel.Back() {
 		m.IsBack = true
+		m.client.StopLiveUpdates()
 	}
 	if m.submodel.Quit() {
 		m.IsQuit = true
+		m.client.StopLiveUpdates()
 	}
 	return m, cmd
 }
 
 func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
 	m.submodel.SetSize(width, height)
 }
 
 func (m Model) View() string {
-	return m.submodel.View()
+	if !m.showTasks {
+		return m.submodel.View()
+	}
+	fg := m.tasks.View(min(m.Width, 60))
+	// place the tasks panel in the bottom right corner
+	x := m.Width - lipgloss.Width(fg)
+	y := m.Height - lipgloss.Height(fg)
+	return overlay.PlaceOverlay(max(x, 0), max(y, 0), fg, m.submodel.View())
 }
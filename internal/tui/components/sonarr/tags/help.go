@@ -0,0 +1,55 @@
+package tags
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMap struct {
+	CursorUp   key.Binding
+	CursorDown key.Binding
+	Create     key.Binding
+	Rename     key.Binding
+	Delete     key.Binding
+	Reload     key.Binding
+	Quit       key.Binding
+	Back       key.Binding
+	Help       key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	CursorUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Create:     key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "new tag")),
+	Rename:     key.NewBinding(key.WithKeys("enter", "e"), key.WithHelp("enter/e", "rename")),
+	Delete:     key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "delete")),
+	Reload:     key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
+	Quit:       key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:       key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:       key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown},
+		{k.Create, k.Rename, k.Delete, k.Reload},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
+type labelKeyMap struct {
+	Save key.Binding
+	Back key.Binding
+	Quit key.Binding
+}
+
+var LabelKeyMap = labelKeyMap{
+	Save: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "save")),
+	Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	Quit: key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+}
+
+func (k labelKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Save, k.Back, k.Quit},
+	}
+}
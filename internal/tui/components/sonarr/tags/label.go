@@ -0,0 +1,110 @@
+package tags
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+var dialogStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder(), true).
+	BorderForeground(styles.SonarrBlue).
+	Padding(1, 2)
+
+// labelInput lets the user enter the label of a new tag or rename an existing one
+type labelInput struct {
+	common.EmbedableModel
+
+	client *sonarr.Client
+	// tag is nil if a new tag is created
+	tag   *sonarrAPI.TagResource
+	input textinput.Model
+}
+
+func newLabelInput(client *sonarr.Client, tag *sonarrAPI.TagResource, width, height int) *labelInput {
+	m := labelInput{
+		client: client,
+		tag:    tag,
+		input:  textinput.New(),
+	}
+	m.input.Prompt = "Label: "
+	m.input.Placeholder = "e.g. anime"
+	m.input.Cursor.Style = lipgloss.NewStyle().Foreground(styles.SonarrBlue)
+	if tag != nil {
+		m.input.SetValue(tag.Label)
+	}
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m *labelInput) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(LabelKeyMap.FullHelp()),
+		m.input.Focus(),
+	)
+}
+
+func (m *labelInput) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, LabelKeyMap.Quit):
+			m.IsQuit = true
+			return m, nil
+
+		case key.Matches(msg, LabelKeyMap.Back):
+			m.IsBack = true
+			return m, nil
+
+		case key.Matches(msg, LabelKeyMap.Save):
+			return m, m.save()
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// save creates or renames the tag, sonarr only allows lowercase labels
+func (m *labelInput) save() tea.Cmd {
+	label := strings.ToLower(strings.TrimSpace(m.input.Value()))
+	if label == "" {
+		return statusbar.NewErrCmd("The label must not be empty")
+	}
+	m.IsBack = true
+	if m.tag == nil {
+		return m.client.CreateTag(label)
+	}
+	if label == m.tag.Label {
+		return nil
+	}
+	return m.client.RenameTag(m.tag.ID, label)
+}
+
+func (m *labelInput) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+	m.input.Width = max(width-dialogStyle.GetHorizontalFrameSize()-lipgloss.Width(m.input.Prompt)-1, 10)
+}
+
+func (m labelInput) View() string {
+	title := "New tag"
+	if m.tag != nil {
+		title = "Rename " + m.tag.Label
+	}
+
+	var s strings.Builder
+	s.WriteString(lipgloss.NewStyle().Bold(true).Render(title))
+	s.WriteString("\n\n")
+	s.WriteString(m.input.View())
+	return dialogStyle.Width(m.Width - dialogStyle.GetHorizontalBorderSize()).Render(s.String())
+}
@@ -0,0 +1,336 @@
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/confirm"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/muesli/reflow/truncate"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateTags
+	stateLabel
+	stateConfirm
+)
+
+type Model struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	state   state
+	spinner common.Spinner
+	table   table.Model
+	// dialog is the label input or the confirm dialog
+	dialog common.SubModel
+
+	tags []*sonarrAPI.TagDetailsResource
+}
+
+func New(client *sonarr.Client, width, height int) *Model {
+	m := Model{
+		client:  client,
+		state:   stateLoading,
+		spinner: common.NewSpinner(),
+		table:   common.NewTable(),
+	}
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Title() string {
+	return "Tags"
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.client.FetchTagDetails(),
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.TabModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+
+		case stateTags:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				return m, tea.Batch(
+					m.client.FetchTagDetails(),
+					statusbar.NewMessageCmd("Reloading...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.Create):
+				m.state = stateLabel
+				m.dialog = newLabelInput(m.client, nil, min(m.Width, 60), m.Height)
+				return m, m.dialog.Init()
+
+			case key.Matches(msg, DefaultKeyMap.Rename):
+				tag := m.selectedTag()
+				if tag == nil {
+					return m, nil
+				}
+				m.state = stateLabel
+				m.dialog = newLabelInput(m.client, &sonarrAPI.TagResource{ID: tag.ID, Label: tag.Label}, min(m.Width, 60), m.Height)
+				return m, m.dialog.Init()
+
+			case key.Matches(msg, DefaultKeyMap.Delete):
+				if tag := m.selectedTag(); tag != nil {
+					return m, m.confirmDelete(tag)
+				}
+				return m, nil
+			}
+		}
+
+	case sonarr.FetchTagDetailsResult:
+		if m.state == stateLoading {
+			m.state = stateTags
+		}
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch tags")
+		}
+		m.tags = msg.Tags
+		m.updateTable()
+		return m, nil
+
+	case sonarr.SaveTagResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		message := fmt.Sprintf("Renamed tag to %s", msg.Tag.Label)
+		if msg.Created {
+			message = fmt.Sprintf("Created tag %s", msg.Tag.Label)
+		}
+		return m, tea.Batch(
+			statusbar.NewMessageCmd(message),
+			m.client.FetchTagDetails(),
+		)
+
+	case sonarr.DeleteTagResult:
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(msg.Error.Error())
+		}
+		return m, tea.Batch(
+			statusbar.NewMessageCmd(fmt.Sprintf("Deleted tag %s", msg.Label)),
+			m.client.FetchTagDetails(),
+		)
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateTags:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+
+	case stateLabel, stateConfirm:
+		var cmd tea.Cmd
+		m.dialog, cmd = m.dialog.Update(msg)
+
+		if m.dialog.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.dialog.Back() {
+			m.state = stateTags
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m *Model) confirmDelete(tag *sonarrAPI.TagDetailsResource) tea.Cmd {
+	question := fmt.Sprintf("Delete the tag %s?", tag.Label)
+	if tag.InUse() {
+		question = fmt.Sprintf("The tag %s is still in use. Delete it anyway? Sonarr removes it from all series and settings.", tag.Label)
+	}
+
+	m.state = stateConfirm
+	m.dialog = confirm.New("Delete tag", question, m.client.DeleteTag(&sonarrAPI.TagResource{ID: tag.ID, Label: tag.Label}), styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.dialog.Init()
+}
+
+func (m Model) selectedTag() *sonarrAPI.TagDetailsResource {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.tags) {
+		return nil
+	}
+	return m.tags[cursor]
+}
+
+// updateTable sets the rows and columns of the table and distributes the available height
+func (m *Model) updateTable() {
+	rows := make([]table.Row, 0, len(m.tags))
+	for _, tag := range m.tags {
+		rows = append(rows, table.Row{
+			tag.Label,
+			strconv.Itoa(len(tag.SeriesIDs)),
+			strconv.Itoa(len(tag.IndexerIDs)),
+			strconv.Itoa(len(tag.DownloadClientIDs)),
+			strconv.Itoa(len(tag.DelayProfileIDs)),
+			strconv.Itoa(len(tag.ImportListIDs)),
+			strconv.Itoa(len(tag.NotificationIDs)),
+			strconv.Itoa(len(tag.RestrictionIDs) + len(tag.AutoTagIDs)),
+		})
+	}
+
+	// the summary, the table header and the spacing take 5 lines
+	common.FitTable(&m.table, boxStyle, m.Width, m.Height, detailsHeight+5, rows, []string{"Label", "Series", "Indexers", "Download Clients", "Delay Profiles", "Import Lists", "Notifications", "Other"}, 0)
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTable()
+
+	if m.dialog != nil {
+		m.dialog.SetSize(min(width, 60), height)
+	}
+}
+
+// detailsHeight is the height of the series list of the selected tag
+const detailsHeight = 3
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Bold(true)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateTags:
+		return m.tagsView()
+	case stateLabel, stateConfirm:
+		fg := m.dialog.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		// make sure background fills the whole screen
+		bg := m.tagsView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	}
+	return ":("
+}
+
+func (m Model) tagsView() string {
+	var s strings.Builder
+
+	var unused int
+	for _, tag := range m.tags {
+		if !tag.InUse() {
+			unused++
+		}
+	}
+	s.WriteString(subtleStyle.Render(fmt.Sprintf("%d tags • %d unused", len(m.tags), unused)))
+	s.WriteString("\n\n")
+
+	if len(m.tags) == 0 {
+		s.WriteString(subtleStyle.Render("No tags, press ctrl+a to create one"))
+	} else {
+		s.WriteString(m.table.View())
+		s.WriteString("\n\n")
+		s.WriteString(m.detailsView())
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+// detailsView lists the series using the selected tag
+func (m Model) detailsView() string {
+	tag := m.selectedTag()
+	if tag == nil {
+		return ""
+	}
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	lines := []string{titleStyle.Render(fmt.Sprintf("Series tagged %s", tag.Label))}
+
+	titles := make([]string, 0, len(tag.SeriesIDs))
+	for _, s := range m.client.GetSeries() {
+		for _, id := range tag.SeriesIDs {
+			if s.ID == id {
+				titles = append(titles, s.Title)
+				break
+			}
+		}
+	}
+	sort.Strings(titles)
+
+	if len(titles) == 0 {
+		lines = append(lines, subtleStyle.Render("No series"))
+	} else {
+		// the list of titles is wrapped and cut off if there are too many series
+		text := lipgloss.NewStyle().Width(width).Render(strings.Join(titles, ", "))
+		textLines := strings.Split(text, "\n")
+		if len(textLines) > detailsHeight-1 {
+			textLines = textLines[:detailsHeight-1]
+			textLines[len(textLines)-1] = truncate.StringWithTail(textLines[len(textLines)-1], uint(width-1), "") + common.Ellipsis
+		}
+		lines = append(lines, subtleStyle.Render(strings.Join(textLines, "\n")))
+	}
+
+	return strings.Join(lines, "\n")
+}
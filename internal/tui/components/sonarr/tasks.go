@@ -0,0 +1,173 @@
+package sonarr
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	"github.com/muesli/reflow/truncate"
+)
+
+// tasks keeps track of the commands sent to sonarr and polls them until they are finished
+type tasks struct {
+	commands map[int32]*sonarrAPI.CommandResource
+	// order holds the ids of the commands in the order they were started
+	order []int32
+	// finished holds the ids of the finished commands, so late poll replies don't track them again
+	finished map[int32]bool
+	// polling holds the ids of the commands which are polled at the moment
+	polling map[int32]bool
+	// connected is true while the live updates report the state of the commands, they aren't polled then
+	connected bool
+}
+
+func newTasks() *tasks {
+	return &tasks{
+		commands: make(map[int32]*sonarrAPI.CommandResource),
+		finished: make(map[int32]bool),
+		polling:  make(map[int32]bool),
+	}
+}
+
+// update processes a command update and returns the commands to notify the user and to continue polling
+func (t *tasks) update(client *sonarr.Client, msg sonarr.CommandUpdateMsg) tea.Cmd {
+	delete(t.polling, msg.ID)
+	if t.finished[msg.ID] {
+		// the command was already reported as finished, e.g. by a live update
+		return nil
+	}
+
+	if msg.Error != nil {
+		// stop tracking the command, otherwise we would keep polling a command that doesn't exist anymore
+		name := "Command"
+		if command, ok := t.commands[msg.ID]; ok {
+			name = command.Name
+		}
+		t.remove(msg.ID)
+		return statusbar.NewErrCmd(fmt.Sprintf("%s: failed to fetch state", name))
+	}
+
+	cmd := t.set(msg.Command)
+	if sonarr.IsCommandFinished(msg.Command) {
+		return cmd
+	}
+	return tea.Batch(cmd, t.poll(client, msg.ID))
+}
+
+// poll polls the state of the command, unless it's reported by the live updates or polled already
+func (t *tasks) poll(client *sonarr.Client, id int32) tea.Cmd {
+	if t.connected || t.polling[id] {
+		return nil
+	}
+	t.polling[id] = true
+	return client.PollCommand(id)
+}
+
+// setConnected sets the state of the live updates.
+// If they were lost, the tracked commands are polled again.
+func (t *tasks) setConnected(client *sonarr.Client, connected bool) tea.Cmd {
+	t.connected = connected
+	cmds := make([]tea.Cmd, 0, len(t.order))
+	for _, id := range t.order {
+		cmds = append(cmds, t.poll(client, id))
+	}
+	return tea.Batch(cmds...)
+}
+
+// live processes a command pushed by the signalr hub.
+// Only commands we are already tracking are considered, sonarr also reports its scheduled tasks.
+func (t *tasks) live(command *sonarrAPI.CommandResource) tea.Cmd {
+	if _, ok := t.commands[command.ID]; !ok {
+		return nil
+	}
+	return t.set(command)
+}
+
+// set stores the state of the command and returns a statusbar message if the status changed
+func (t *tasks) set(command *sonarrAPI.CommandResource) tea.Cmd {
+	previous, tracked := t.commands[command.ID]
+
+	if sonarr.IsCommandFinished(command) {
+		t.remove(command.ID)
+		t.finished[command.ID] = true
+		if command.Status == sonarrAPI.CommandStatusCompleted {
+			return statusbar.NewMessageCmd(sonarr.CommandSummary(command), statusbar.WithMessageTimeout(4))
+		}
+		return statusbar.NewErrCmd(sonarr.CommandSummary(command))
+	}
+
+	if !tracked {
+		t.order = append(t.order, command.ID)
+	}
+	t.commands[command.ID] = command
+
+	if !tracked || previous.Status != command.Status {
+		return statusbar.NewMessageCmd(sonarr.CommandSummary(command), statusbar.WithMessageTimeout(2))
+	}
+	return nil
+}
+
+func (t *tasks) remove(id int32) {
+	delete(t.commands, id)
+	for i, v := range t.order {
+		if v == id {
+			t.order = append(t.order[:i], t.order[i+1:]...)
+			return
+		}
+	}
+}
+
+var (
+	tasksStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			BorderForeground(styles.SonarrBlue).
+			Padding(0, 1)
+
+	tasksTitleStyle = lipgloss.NewStyle().
+			Foreground(styles.SonarrBlue).
+			Bold(true)
+
+	tasksSubtleStyle = lipgloss.NewStyle().
+				Foreground(styles.SubtleColor)
+)
+
+// View renders a panel listing all running commands
+func (t tasks) View(width int) string {
+	contentWidth := width - tasksStyle.GetHorizontalFrameSize()
+
+	var s strings.Builder
+	s.WriteString(tasksTitleStyle.Render("Running tasks"))
+	s.WriteString("\n\n")
+
+	if len(t.order) == 0 {
+		s.WriteString(tasksSubtleStyle.Render("No running tasks"))
+	}
+
+	for i, id := range t.order {
+		command := t.commands[id]
+
+		var elapsed time.Duration
+		switch {
+		case !command.Started.IsZero():
+			elapsed = time.Since(command.Started).Round(time.Second)
+		case !command.Queued.IsZero():
+			elapsed = time.Since(command.Queued).Round(time.Second)
+		}
+		info := fmt.Sprintf(" %s • %s", command.Status, elapsed)
+
+		name := truncate.StringWithTail(command.Name, uint(max(contentWidth-lipgloss.Width(info), 0)), "…")
+		s.WriteString(name)
+		s.WriteString(tasksSubtleStyle.Render(info))
+		if i < len(t.order)-1 {
+			s.WriteString("\n")
+		}
+	}
+
+	return tasksStyle.Width(contentWidth + tasksStyle.GetHorizontalPadding()).Render(s.String())
+}
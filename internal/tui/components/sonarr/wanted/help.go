@@ -0,0 +1,43 @@
+package wanted
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMap struct {
+	CursorUp       key.Binding
+	CursorDown     key.Binding
+	NextPage       key.Binding
+	PrevPage       key.Binding
+	Toggle         key.Binding
+	TogglePage     key.Binding
+	Quit           key.Binding
+	Back           key.Binding
+	Help           key.Binding
+	Reload         key.Binding
+	SearchSelected key.Binding
+	SearchAll      key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	CursorUp:       key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	CursorDown:     key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	NextPage:       key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next page")),
+	PrevPage:       key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "prev page")),
+	Toggle:         key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "select")),
+	TogglePage:     key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "select page")),
+	Quit:           key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q/ctrl+c", "quit")),
+	Back:           key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Help:           key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+	Reload:         key.NewBinding(key.WithKeys("r", "f5"), key.WithHelp("r", "reload")),
+	SearchSelected: key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "search selected")),
+	SearchAll:      key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "search all")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.NextPage, k.PrevPage},
+		{k.Toggle, k.TogglePage, k.SearchSelected, k.SearchAll},
+		{k.Reload, k.Help, k.Back, k.Quit},
+	}
+}
@@ -0,0 +1,381 @@
+package wanted
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/confirm"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/overlay"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateWanted
+	stateConfirm
+)
+
+type Model struct {
+	common.EmbedableModel
+
+	client  *sonarr.Client
+	wanted  sonarr.Wanted
+	state   state
+	spinner common.Spinner
+	table   table.Model
+	confirm common.SubModel
+
+	episodes *sonarrAPI.EpisodeResourcePagingResource
+	pager    common.Pager
+	// selected episodes by id, the selection is kept when switching pages
+	selected common.Selection[int32]
+}
+
+func New(client *sonarr.Client, wanted sonarr.Wanted, width, height int) *Model {
+	m := Model{
+		client:   client,
+		wanted:   wanted,
+		state:    stateLoading,
+		spinner:  common.NewSpinner(),
+		table:    common.NewTable(),
+		pager:    common.NewPager(sonarr.WantedPageSize),
+		selected: make(common.Selection[int32]),
+	}
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Title() string {
+	if m.wanted == sonarr.WantedCutoffUnmet {
+		return "Cutoff Unmet"
+	}
+	return "Missing"
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.client.FetchWanted(m.wanted, m.pager.Page),
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.TabModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.state {
+		case stateLoading:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+			}
+
+		case stateWanted:
+			switch {
+			case key.Matches(msg, DefaultKeyMap.Back):
+				m.IsBack = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Quit):
+				m.IsQuit = true
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Reload):
+				return m, tea.Batch(
+					m.client.FetchWanted(m.wanted, m.pager.Page),
+					statusbar.NewMessageCmd("Reloading...", statusbar.WithMessageTimeout(2)),
+				)
+
+			case key.Matches(msg, DefaultKeyMap.NextPage):
+				if m.pager.NextPage() {
+					return m, m.client.FetchWanted(m.wanted, m.pager.Page)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.PrevPage):
+				if m.pager.PrevPage() {
+					return m, m.client.FetchWanted(m.wanted, m.pager.Page)
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.Toggle):
+				if episode := m.selectedEpisode(); episode != nil {
+					m.selected.Toggle(episode.ID)
+					m.updateTable()
+				}
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.TogglePage):
+				m.togglePage()
+				m.updateTable()
+				return m, nil
+
+			case key.Matches(msg, DefaultKeyMap.SearchSelected):
+				return m, m.searchSelected()
+
+			case key.Matches(msg, DefaultKeyMap.SearchAll):
+				return m, m.confirmSearchAll()
+			}
+		}
+
+	case sonarr.FetchWantedResult:
+		// the other wanted tab receives the result as well
+		if msg.Wanted != m.wanted {
+			return m, nil
+		}
+		if m.state == stateLoading {
+			m.state = stateWanted
+		}
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd(fmt.Sprintf("Failed to fetch %s episodes", strings.ToLower(m.Title())))
+		}
+		m.episodes = msg.Episodes
+		// the page might not exist anymore, if episodes were found in the meantime
+		if m.pager.SetTotal(int(m.episodes.TotalRecords)) {
+			return m, m.client.FetchWanted(m.wanted, m.pager.Page)
+		}
+		m.updateTable()
+		return m, nil
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateWanted:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+
+	case stateConfirm:
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+
+		if m.confirm.Quit() {
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.confirm.Back() {
+			m.state = stateWanted
+			return m, tea.Batch(
+				cmd,
+				statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+			)
+		}
+
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// togglePage selects all episodes of the current page or deselects them if all of them are selected already
+func (m *Model) togglePage() {
+	records := m.records()
+	ids := make([]int32, len(records))
+	for i, episode := range records {
+		ids[i] = episode.ID
+	}
+	m.selected.ToggleAll(ids)
+}
+
+// searchSelected searches for the selected episodes or the episode under the cursor if nothing is selected
+func (m *Model) searchSelected() tea.Cmd {
+	ids := m.selected.Keys()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	if len(ids) == 0 {
+		episode := m.selectedEpisode()
+		if episode == nil {
+			return nil
+		}
+		ids = append(ids, episode.ID)
+	}
+
+	m.selected.Clear()
+	m.updateTable()
+
+	message := fmt.Sprintf("Searching for %d episodes...", len(ids))
+	if len(ids) == 1 {
+		message = "Searching for 1 episode..."
+	}
+	return tea.Batch(
+		m.client.AutomaticSearchEpisode(ids...),
+		statusbar.NewMessageCmd(message, statusbar.WithMessageTimeout(2)),
+	)
+}
+
+func (m *Model) confirmSearchAll() tea.Cmd {
+	search := m.client.AutomaticSearchMissing()
+	question := "Search for all missing episodes?"
+	if m.wanted == sonarr.WantedCutoffUnmet {
+		search = m.client.AutomaticSearchCutoffUnmet()
+		question = "Search for all episodes which don't meet the quality cutoff?"
+	}
+	if m.episodes != nil {
+		question = fmt.Sprintf("%s This will search for %d episodes.", question, m.episodes.TotalRecords)
+	}
+
+	onConfirm := tea.Batch(
+		search,
+		statusbar.NewMessageCmd(fmt.Sprintf("Searching for all %s episodes...", strings.ToLower(m.Title())), statusbar.WithMessageTimeout(2)),
+	)
+
+	m.state = stateConfirm
+	m.confirm = confirm.New("Search all", question, onConfirm, styles.SonarrBlue, min(m.Width, 60), m.Height)
+	return m.confirm.Init()
+}
+
+func (m Model) records() []*sonarrAPI.EpisodeResource {
+	if m.episodes == nil {
+		return nil
+	}
+	return m.episodes.Records
+}
+
+func (m Model) selectedEpisode() *sonarrAPI.EpisodeResource {
+	records := m.records()
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(records) {
+		return nil
+	}
+	return records[cursor]
+}
+
+// updateTable sets the rows and columns of the table and distributes the available height
+func (m *Model) updateTable() {
+	titles := []string{"", "Series", "Episode", "Title", "Air Date"}
+	if m.wanted == sonarr.WantedCutoffUnmet {
+		titles = append(titles, "Quality")
+	}
+
+	records := m.records()
+	rows := make([]table.Row, 0, len(records))
+	for _, episode := range records {
+		selected := common.Unselected
+		if m.selected[episode.ID] {
+			selected = common.Selected
+		}
+		row := table.Row{
+			selected,
+			seriesTitle(episode),
+			fmt.Sprintf("S%02dE%02d", episode.SeasonNumber, episode.EpisodeNumber),
+			episode.Title,
+			airDate(episode),
+		}
+		if m.wanted == sonarr.WantedCutoffUnmet {
+			row = append(row, quality(episode))
+		}
+		rows = append(rows, row)
+	}
+
+	// the page info, the table header and the spacing take 4 lines
+	common.FitTable(&m.table, boxStyle, m.Width, m.Height, 4, rows, titles, 3)
+}
+
+func seriesTitle(episode *sonarrAPI.EpisodeResource) string {
+	if episode.Series != nil {
+		return episode.Series.Title
+	}
+	return episode.SeriesTitle
+}
+
+func airDate(episode *sonarrAPI.EpisodeResource) string {
+	if episode.AirDateUTC.IsZero() {
+		return "-"
+	}
+	return episode.AirDateUTC.Local().Format("02.01.2006 15:04")
+}
+
+func quality(episode *sonarrAPI.EpisodeResource) string {
+	if episode.EpisodeFile == nil || episode.EpisodeFile.Quality == nil || episode.EpisodeFile.Quality.Quality == nil {
+		return "Unknown"
+	}
+	return episode.EpisodeFile.Quality.Quality.Name
+}
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateWanted:
+		return m.wantedView()
+	case stateConfirm:
+		fg := m.confirm.View()
+		x := ((m.Width - lipgloss.Width(fg)) / 2)
+		y := ((m.Height - lipgloss.Height(fg)) / 2)
+		// make sure background fills the whole screen
+		bg := m.wantedView()
+		return overlay.PlaceOverlay(x, y, fg, bg)
+	}
+	return ":("
+}
+
+func (m Model) wantedView() string {
+	var s strings.Builder
+
+	var total int32
+	if m.episodes != nil {
+		total = m.episodes.TotalRecords
+	}
+	info := fmt.Sprintf("Page %d/%d • %d episodes", m.pager.Page, m.pager.TotalPages(), total)
+	if len(m.selected) > 0 {
+		info += fmt.Sprintf(" • %d selected", len(m.selected))
+	}
+	s.WriteString(subtleStyle.Render(info))
+	s.WriteString("\n\n")
+
+	if total == 0 {
+		s.WriteString(subtleStyle.Render(fmt.Sprintf("No %s episodes", strings.ToLower(m.Title()))))
+	} else {
+		s.WriteString(m.table.View())
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTable()
+
+	if m.confirm != nil {
+		m.confirm.SetSize(min(width, 60), height)
+	}
+}
@@ -0,0 +1,133 @@
+package system
+
+import (
+	"time"
+
+	"github.com/jon4hz/submarr/internal/core/radarr"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+)
+
+// Info holds the system information of a client, independent of the client it came from
+type Info struct {
+	Status Status
+	Health []Health
+	Disks  []Disk
+	Tasks  []Task
+}
+
+type Status struct {
+	AppName        string
+	Version        string
+	Branch         string
+	OsName         string
+	OsVersion      string
+	IsDocker       bool
+	RuntimeName    string
+	RuntimeVersion string
+	AppData        string
+	StartTime      time.Time
+}
+
+type Health struct {
+	Source string
+	// Type is one of ok, notice, warning or error
+	Type    string
+	Message string
+	WikiURL string
+}
+
+type Disk struct {
+	Path  string
+	Label string
+	Free  int64
+	Total int64
+}
+
+// FreePercent returns the free space of the disk in percent
+func (d Disk) FreePercent() float64 {
+	if d.Total <= 0 {
+		return 0
+	}
+	return float64(d.Free) / float64(d.Total) * 100
+}
+
+type Task struct {
+	Name string
+	// TaskName is the name of the command which runs the task
+	TaskName      string
+	Interval      time.Duration
+	LastExecution time.Time
+	NextExecution time.Time
+}
+
+func fromSonarr(res sonarr.FetchSystemResult) *Info {
+	info := &Info{
+		Status: Status{
+			AppName:        res.Status.AppName,
+			Version:        res.Status.Version,
+			Branch:         res.Status.Branch,
+			OsName:         res.Status.OsName,
+			OsVersion:      res.Status.OsVersion,
+			IsDocker:       res.Status.IsDocker,
+			RuntimeName:    res.Status.RuntimeName,
+			RuntimeVersion: res.Status.RuntimeVersion,
+			AppData:        res.Status.AppData,
+			StartTime:      res.Status.StartTime,
+		},
+		Health: make([]Health, len(res.Health)),
+		Disks:  make([]Disk, len(res.DiskSpace)),
+		Tasks:  make([]Task, len(res.Tasks)),
+	}
+	for i, h := range res.Health {
+		info.Health[i] = Health{Source: h.Source, Type: string(h.Type), Message: h.Message, WikiURL: h.WikiURL}
+	}
+	for i, d := range res.DiskSpace {
+		info.Disks[i] = Disk{Path: d.Path, Label: d.Label, Free: d.FreeSpace, Total: d.TotalSpace}
+	}
+	for i, t := range res.Tasks {
+		info.Tasks[i] = Task{
+			Name:          t.Name,
+			TaskName:      t.TaskName,
+			Interval:      time.Duration(t.Interval) * time.Minute,
+			LastExecution: t.LastExecution,
+			NextExecution: t.NextExecution,
+		}
+	}
+	return info
+}
+
+func fromRadarr(res radarr.FetchSystemResult) *Info {
+	info := &Info{
+		Status: Status{
+			AppName:        res.Status.AppName,
+			Version:        res.Status.Version,
+			Branch:         res.Status.Branch,
+			OsName:         res.Status.OsName,
+			OsVersion:      res.Status.OsVersion,
+			IsDocker:       res.Status.IsDocker,
+			RuntimeName:    res.Status.RuntimeName,
+			RuntimeVersion: res.Status.RuntimeVersion,
+			AppData:        res.Status.AppData,
+			StartTime:      res.Status.StartTime,
+		},
+		Health: make([]Health, len(res.Health)),
+		Disks:  make([]Disk, len(res.DiskSpace)),
+		Tasks:  make([]Task, len(res.Tasks)),
+	}
+	for i, h := range res.Health {
+		info.Health[i] = Health{Source: h.Source, Type: string(h.Type), Message: h.Message, WikiURL: h.WikiURL}
+	}
+	for i, d := range res.DiskSpace {
+		info.Disks[i] = Disk{Path: d.Path, Label: d.Label, Free: d.FreeSpace, Total: d.TotalSpace}
+	}
+	for i, t := range res.Tasks {
+		info.Tasks[i] = Task{
+			Name:          t.Name,
+			TaskName:      t.TaskName,
+			Interval:      time.Duration(t.Interval) * time.Minute,
+			LastExecution: t.LastExecution,
+			NextExecution: t.NextExecution,
+		}
+	}
+	return info
+}
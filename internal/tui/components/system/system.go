@@ -0,0 +1,441 @@
+package system
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/jon4hz/submarr/internal/core/radarr"
+	"github.com/jon4hz/submarr/internal/core/sonarr"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	sonarrAPI "github.com/jon4hz/submarr/pkg/sonarr"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/muesli/reflow/truncate"
+)
+
+type state int
+
+const (
+	stateLoading state = iota + 1
+	stateSystem
+)
+
+// runTaskZoneID is the zone of the "run now" button
+const runTaskZoneID = "system-run-task"
+
+// refetchDelay is the time after which the tasks are reloaded once a task was started
+const refetchDelay = 3 * time.Second
+
+// Model shows the status, the health issues, the disk space and the scheduled tasks of a client
+type Model struct {
+	common.EmbedableModel
+
+	fetch   func() tea.Cmd
+	runTask func(taskName string) tea.Cmd
+	color   lipgloss.TerminalColor
+
+	state   state
+	spinner common.Spinner
+	table   table.Model
+
+	info *Info
+}
+
+// NewSonarr returns the system tab of a sonarr client
+func NewSonarr(c *sonarr.Client, width, height int) common.TabModel {
+	return newModel(c.FetchSystem, c.RunTask, styles.SonarrBlue, width, height)
+}
+
+// NewRadarr returns the system tab of a radarr client
+func NewRadarr(c *radarr.Client, width, height int) common.TabModel {
+	return newModel(c.FetchSystem, c.RunTask, styles.RadarrOrange, width, height)
+}
+
+func newModel(fetch func() tea.Cmd, runTask func(string) tea.Cmd, color lipgloss.TerminalColor, width, height int) *Model {
+	m := Model{
+		fetch:   fetch,
+		runTask: runTask,
+		color:   color,
+		state:   stateLoading,
+		spinner: common.NewSpinner(),
+		table:   common.NewTable(),
+	}
+
+	m.table.Focus()
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m Model) Title() string {
+	return "System"
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(
+		statusbar.NewHelpCmd(DefaultKeyMap.FullHelp()),
+		m.spinner.Tick,
+		m.fetch(),
+	)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.TabModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, DefaultKeyMap.Back):
+			m.IsBack = true
+			return m, nil
+
+		case key.Matches(msg, DefaultKeyMap.Quit):
+			m.IsQuit = true
+			return m, nil
+		}
+
+		if m.state != stateSystem {
+			break
+		}
+		switch {
+		case key.Matches(msg, DefaultKeyMap.Reload):
+			return m, tea.Batch(
+				m.fetch(),
+				statusbar.NewMessageCmd("Reloading...", statusbar.WithMessageTimeout(2)),
+			)
+
+		case key.Matches(msg, DefaultKeyMap.RunTask):
+			return m, m.runSelectedTask()
+		}
+
+	case tea.MouseMsg:
+		if m.state != stateSystem {
+			break
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.table.MoveUp(1)
+			return m, nil
+
+		case tea.MouseButtonWheelDown:
+			m.table.MoveDown(1)
+			return m, nil
+
+		case tea.MouseButtonLeft:
+			if zone.Get(runTaskZoneID).InBounds(msg) {
+				return m, m.runSelectedTask()
+			}
+		}
+		return m, nil
+
+	case sonarr.FetchSystemResult:
+		m.state = stateSystem
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch system status")
+		}
+		m.setInfo(fromSonarr(msg))
+		return m, nil
+
+	case radarr.FetchSystemResult:
+		m.state = stateSystem
+		if msg.Error != nil {
+			return m, statusbar.NewErrCmd("Failed to fetch system status")
+		}
+		m.setInfo(fromRadarr(msg))
+		return m, nil
+
+	case sonarr.LiveUpdateMsg:
+		if m.state != stateLoading && msg.Affects(sonarrAPI.SignalREventHealth, sonarrAPI.SignalREventSystemTask) {
+			return m, m.fetch()
+		}
+		return m, nil
+	}
+
+	switch m.state {
+	case stateLoading:
+		var cmd tea.Cmd
+		m.spinner.Model, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case stateSystem:
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// runSelectedTask starts the selected task and reloads the tasks shortly after,
+// so the new execution times are shown.
+func (m *Model) runSelectedTask() tea.Cmd {
+	task := m.selectedTask()
+	if task == nil {
+		return nil
+	}
+	return tea.Batch(
+		m.runTask(task.TaskName),
+		statusbar.NewMessageCmd(fmt.Sprintf("Running %s...", task.Name), statusbar.WithMessageTimeout(2)),
+		tea.Tick(refetchDelay, func(time.Time) tea.Msg {
+			return m.fetch()()
+		}),
+	)
+}
+
+func (m Model) selectedTask() *Task {
+	if m.info == nil {
+		return nil
+	}
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.info.Tasks) {
+		return nil
+	}
+	return &m.info.Tasks[cursor]
+}
+
+func (m *Model) setInfo(info *Info) {
+	m.info = info
+	m.updateTable()
+}
+
+// updateTable sets the rows and columns of the table and gives it the height which isn't used by the other sections
+func (m *Model) updateTable() {
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	var rows []table.Row
+	if m.info != nil {
+		rows = make([]table.Row, 0, len(m.info.Tasks))
+		for _, task := range m.info.Tasks {
+			rows = append(rows, table.Row{
+				task.Name,
+				formatInterval(task.Interval),
+				formatTime(task.LastExecution),
+				formatTime(task.NextExecution),
+			})
+		}
+	}
+
+	common.SetTableRows(&m.table, width, rows, []string{"Name", "Interval", "Last Execution", "Next Execution"}, 0)
+
+	// the spacing, the tasks title, the table header and the button take 7 lines
+	available := m.Height - boxStyle.GetVerticalFrameSize() - lipgloss.Height(m.overviewView()) - 7
+	m.table.SetHeight(max(min(len(rows), available), 1))
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	m.updateTable()
+}
+
+// formatInterval formats the interval of a task in the largest unit it can be expressed in
+func formatInterval(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "-"
+	case d%(24*time.Hour) == 0:
+		return pluralize(int(d/(24*time.Hour)), "day")
+	case d%time.Hour == 0:
+		return pluralize(int(d/time.Hour), "hour")
+	default:
+		return pluralize(int(d/time.Minute), "minute")
+	}
+}
+
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return humanize.Time(t)
+}
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1)
+
+	titleStyle = lipgloss.NewStyle().
+			Bold(true)
+
+	keyStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor).
+			PaddingRight(2)
+
+	subtleStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+
+	buttonStyle = lipgloss.NewStyle().
+			Align(lipgloss.Center).
+			Border(lipgloss.RoundedBorder(), true).
+			Padding(0, 1, 0)
+
+	healthStyles = map[string]lipgloss.Style{
+		string(sonarrAPI.HealthCheckNotice):  lipgloss.NewStyle().Foreground(styles.SubtleColor),
+		string(sonarrAPI.HealthCheckWarning): lipgloss.NewStyle().Foreground(styles.WarningColor),
+		string(sonarrAPI.HealthCheckError):   lipgloss.NewStyle().Foreground(styles.ErrorColor),
+	}
+
+	healthIcons = map[string]string{
+		string(sonarrAPI.HealthCheckNotice):  "ℹ",
+		string(sonarrAPI.HealthCheckWarning): "⚠",
+		string(sonarrAPI.HealthCheckError):   "✖",
+	}
+)
+
+func (m Model) View() string {
+	switch m.state {
+	case stateLoading:
+		return lipgloss.NewStyle().Padding(1, 0, 0, 0).Render(m.spinner.View())
+	case stateSystem:
+		return m.systemView()
+	}
+	return ":("
+}
+
+func (m Model) systemView() string {
+	var s strings.Builder
+
+	if m.info == nil {
+		s.WriteString(subtleStyle.Render("No system information, press r to reload"))
+	} else {
+		s.WriteString(m.overviewView())
+		s.WriteString("\n\n")
+		s.WriteString(titleStyle.Render("Tasks"))
+		s.WriteString("\n")
+		s.WriteString(m.table.View())
+		s.WriteString("\n")
+		s.WriteString(zone.Mark(runTaskZoneID, buttonStyle.BorderForeground(m.color).Render("Run now")))
+	}
+
+	return boxStyle.
+		Width(m.Width - boxStyle.GetHorizontalBorderSize()).
+		Height(m.Height - boxStyle.GetVerticalFrameSize()).
+		MaxHeight(m.Height).
+		Render(s.String())
+}
+
+// overviewView renders everything above the tasks
+func (m Model) overviewView() string {
+	if m.info == nil {
+		return ""
+	}
+	width := m.Width - boxStyle.GetHorizontalFrameSize()
+
+	status := m.statusView()
+	// show the disks next to the status if there is enough space
+	var top string
+	if statusWidth := lipgloss.Width(status) + 4; width-statusWidth >= 50 {
+		top = lipgloss.JoinHorizontal(lipgloss.Top,
+			lipgloss.NewStyle().Width(statusWidth).Render(status),
+			m.disksView(width-statusWidth),
+		)
+	} else {
+		top = lipgloss.JoinVertical(lipgloss.Left, status, "", m.disksView(width))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, top, "", m.healthView(width))
+}
+
+func (m Model) statusView() string {
+	status := m.info.Status
+
+	version := status.Version
+	if status.Branch != "" {
+		version = fmt.Sprintf("%s (%s)", version, status.Branch)
+	}
+	osName := strings.TrimSpace(fmt.Sprintf("%s %s", status.OsName, status.OsVersion))
+	if status.IsDocker {
+		osName += " • docker"
+	}
+	uptime := "-"
+	if !status.StartTime.IsZero() {
+		uptime = strings.TrimSpace(humanize.RelTime(status.StartTime, time.Now(), "", ""))
+	}
+
+	kvs := [][2]string{
+		{"Version", version},
+		{"OS", osName},
+		{"Runtime", strings.TrimSpace(fmt.Sprintf("%s %s", status.RuntimeName, status.RuntimeVersion))},
+		{"Uptime", uptime},
+		{"App Data", status.AppData},
+	}
+	var longestKey int
+	for _, kv := range kvs {
+		longestKey = max(longestKey, lipgloss.Width(kv[0]))
+	}
+
+	lines := []string{titleStyle.Render("Status")}
+	for _, kv := range kvs {
+		lines = append(lines, keyStyle.Width(longestKey+keyStyle.GetHorizontalPadding()).Render(kv[0])+kv[1])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// disksView renders a bar with the used space of every disk
+func (m Model) disksView(width int) string {
+	lines := []string{titleStyle.Render("Disk Space")}
+	if len(m.info.Disks) == 0 {
+		lines = append(lines, subtleStyle.Render("No disks"))
+		return strings.Join(lines, "\n")
+	}
+
+	names := make([]string, len(m.info.Disks))
+	stats := make([]string, len(m.info.Disks))
+	var nameWidth, statsWidth int
+	for i, disk := range m.info.Disks {
+		names[i] = disk.Path
+		if names[i] == "" {
+			names[i] = disk.Label
+		}
+		names[i] = truncate.StringWithTail(names[i], uint(max(width/3, 1)), common.Ellipsis)
+		stats[i] = fmt.Sprintf("%.0f%% free • %s / %s", disk.FreePercent(), humanize.IBytes(uint64(disk.Free)), humanize.IBytes(uint64(disk.Total)))
+		nameWidth = max(nameWidth, lipgloss.Width(names[i]))
+		statsWidth = max(statsWidth, lipgloss.Width(stats[i]))
+	}
+
+	barWidth := max(width-nameWidth-statsWidth-2, 0)
+	barStyle := lipgloss.NewStyle().Foreground(m.color)
+	for i, disk := range m.info.Disks {
+		used := 0
+		if disk.Total > 0 {
+			used = int(float64(barWidth) * float64(disk.Total-disk.Free) / float64(disk.Total))
+		}
+		used = min(max(used, 0), barWidth)
+		bar := barStyle.Render(strings.Repeat("█", used)) + subtleStyle.Render(strings.Repeat("░", barWidth-used))
+		name := names[i] + strings.Repeat(" ", nameWidth-lipgloss.Width(names[i]))
+		lines = append(lines, fmt.Sprintf("%s %s %s", name, bar, subtleStyle.Render(stats[i])))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// healthView lists the health issues with a link to the wiki
+func (m Model) healthView(width int) string {
+	lines := []string{titleStyle.Render("Health")}
+	for _, health := range m.info.Health {
+		style, ok := healthStyles[health.Type]
+		if !ok {
+			continue
+		}
+		lines = append(lines, style.Render(truncate.StringWithTail(fmt.Sprintf("%s %s", healthIcons[health.Type], health.Message), uint(width), common.Ellipsis)))
+		if health.WikiURL != "" {
+			lines = append(lines, subtleStyle.Render(truncate.StringWithTail("  "+health.WikiURL, uint(width), common.Ellipsis)))
+		}
+	}
+	if len(lines) == 1 {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.OkColor).Render("✔ No issues"))
+	}
+	return strings.Join(lines, "\n")
+}
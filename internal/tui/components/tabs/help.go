@@ -0,0 +1,19 @@
+package tabs
+
+import "github.com/charmbracelet/bubbles/key"
+
+type KeyMap struct {
+	NextTab key.Binding
+	PrevTab key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	NextTab: key.NewBinding(key.WithKeys("ctrl+right", "ctrl+pgdown"), key.WithHelp("ctrl+→", "next tab")),
+	PrevTab: key.NewBinding(key.WithKeys("ctrl+left", "ctrl+pgup"), key.WithHelp("ctrl+←", "prev tab")),
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.NextTab, k.PrevTab},
+	}
+}
@@ -0,0 +1,222 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jon4hz/submarr/internal/tui/common"
+	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
+	"github.com/jon4hz/submarr/internal/tui/styles"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+// Model renders a tab bar and forwards all messages to its tabs.
+// Key and mouse events are only forwarded to the active tab.
+type Model struct {
+	common.EmbedableModel
+
+	tabs   []common.TabModel
+	active int
+
+	// initialized holds whether the Init method of a tab was called already
+	initialized []bool
+	// help holds the last help of each tab, so it can be restored when switching tabs
+	help []statusbar.SetHelpMsg
+
+	color lipgloss.TerminalColor
+}
+
+func New(color lipgloss.TerminalColor, width, height int, tabs ...common.TabModel) *Model {
+	m := Model{
+		tabs:        tabs,
+		initialized: make([]bool, len(tabs)),
+		help:        make([]statusbar.SetHelpMsg, len(tabs)),
+		color:       color,
+	}
+
+	m.SetSize(width, height)
+
+	return &m
+}
+
+func (m *Model) Init() tea.Cmd {
+	return m.activate(0)
+}
+
+func (m *Model) Update(msg tea.Msg) (common.SubModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, DefaultKeyMap.NextTab):
+			return m, m.activate((m.active + 1) % len(m.tabs))
+
+		case key.Matches(msg, DefaultKeyMap.PrevTab):
+			return m, m.activate((m.active - 1 + len(m.tabs)) % len(m.tabs))
+		}
+		return m, m.updateActive(msg)
+
+	case tea.MouseMsg:
+		if msg.Button == tea.MouseButtonLeft {
+			for i, tab := range m.tabs {
+				if i != m.active && zone.Get(tabZoneID(tab)).InBounds(msg) {
+					return m, m.activate(i)
+				}
+			}
+		}
+		return m, m.updateActive(msg)
+
+	case tabHelpMsg:
+		// remember the help, so it can be restored when the tab is activated again.
+		// Only the help of the active tab is shown.
+		m.help[msg.index] = msg.help
+		if msg.index == m.active {
+			return m, statusbar.NewHelpCmd(msg.help)
+		}
+		return m, nil
+
+	case statusbar.SetHelpMsg:
+		// help which couldn't be tagged, e.g. from a sequence, belongs to the active tab
+		m.help[m.active] = msg
+		return m, nil
+	}
+
+	// all other messages are forwarded to every initialized tab,
+	// so that responses to commands of an inactive tab don't get lost.
+	var cmds []tea.Cmd
+	for i := range m.tabs {
+		if !m.initialized[i] {
+			continue
+		}
+		var cmd tea.Cmd
+		m.tabs[i], cmd = m.tabs[i].Update(msg)
+		cmds = append(cmds, tagHelp(i, cmd))
+	}
+	m.checkActive()
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m *Model) updateActive(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	m.tabs[m.active], cmd = m.tabs[m.active].Update(msg)
+	m.checkActive()
+	return tagHelp(m.active, cmd)
+}
+
+// tabHelpMsg is the help set by the tab with the given index
+type tabHelpMsg struct {
+	index int
+	help  statusbar.SetHelpMsg
+}
+
+// tagHelp wraps the command of a tab, so that its help messages can be told apart from the help of other tabs.
+// Messages of inactive tabs are still processed, but their help mustn't replace the help of the active tab.
+func tagHelp(index int, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		switch msg := cmd().(type) {
+		case statusbar.SetHelpMsg:
+			return tabHelpMsg{index: index, help: msg}
+		case tea.BatchMsg:
+			cmds := make([]tea.Cmd, len(msg))
+			for i, c := range msg {
+				cmds[i] = tagHelp(index, c)
+			}
+			return tea.BatchMsg(cmds)
+		default:
+			return msg
+		}
+	}
+}
+
+// checkActive propagates the quit and back state of the active tab
+func (m *Model) checkActive() {
+	if m.tabs[m.active].Quit() {
+		m.IsQuit = true
+	}
+	if m.tabs[m.active].Back() {
+		m.IsBack = true
+	}
+}
+
+// activate switches to the tab with the given index.
+// The tab is initialized the first time it gets activated, afterwards only its help is restored.
+func (m *Model) activate(i int) tea.Cmd {
+	m.active = i
+	if !m.initialized[i] {
+		m.initialized[i] = true
+		return tagHelp(i, m.tabs[i].Init())
+	}
+	if m.help[i] != nil {
+		return statusbar.NewHelpCmd(m.help[i])
+	}
+	return nil
+}
+
+// Active returns the index of the active tab
+func (m Model) Active() int {
+	return m.active
+}
+
+func tabZoneID(tab common.TabModel) string {
+	return fmt.Sprintf("tab-%s", tab.Title())
+}
+
+var (
+	tabBarStyle = lipgloss.NewStyle().
+			Padding(0, 1).
+			MarginBottom(1)
+
+	tabStyle = lipgloss.NewStyle().
+			Padding(0, 1).
+			Foreground(styles.SubtleColor)
+
+	activeTabStyle = tabStyle.Copy().
+			Bold(true).
+			Underline(true)
+
+	hintStyle = lipgloss.NewStyle().
+			Foreground(styles.SubtleColor)
+)
+
+func (m Model) tabBarView() string {
+	titles := make([]string, len(m.tabs))
+	for i, tab := range m.tabs {
+		if i == m.active {
+			titles[i] = zone.Mark(tabZoneID(tab), activeTabStyle.Foreground(m.color).Render(tab.Title()))
+		} else {
+			titles[i] = zone.Mark(tabZoneID(tab), tabStyle.Render(tab.Title()))
+		}
+	}
+	bar := strings.Join(titles, hintStyle.Render("│"))
+
+	hint := hintStyle.Render("ctrl+←/→ switch tab")
+	width := m.Width - tabBarStyle.GetHorizontalFrameSize()
+	if gap := width - lipgloss.Width(bar) - lipgloss.Width(hint); gap > 0 {
+		bar = lipgloss.JoinHorizontal(lipgloss.Top, bar, strings.Repeat(" ", gap), hint)
+	}
+
+	return tabBarStyle.Render(bar)
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+
+	height -= lipgloss.Height(m.tabBarView())
+	for _, tab := range m.tabs {
+		tab.SetSize(width, height)
+	}
+}
+
+func (m Model) View() string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.tabBarView(),
+		m.tabs[m.active].View(),
+	)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/jon4hz/submarr/internal/logging"
 	"github.com/jon4hz/submarr/internal/tui/common"
 	"github.com/jon4hz/submarr/internal/tui/components/clientslist"
+	"github.com/jon4hz/submarr/internal/tui/components/radarr"
 	"github.com/jon4hz/submarr/internal/tui/components/sonarr"
 	"github.com/jon4hz/submarr/internal/tui/components/statusbar"
 	zone "github.com/lrstanley/bubblezone"
@@ -194,6 +195,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.statusbar, cmd = m.statusbar.Update(msg)
 		cmds = append(cmds, cmd)
 		m.setSize(m.totalWidth, m.totalHeight)
+		// the client model might keep track of the help, e.g. to restore it when switching tabs
+		if m.state == stateClient {
+			m.clientModel, cmd = m.clientModel.Update(msg)
+			cmds = append(cmds, cmd)
+		}
 		return m, tea.Batch(cmds...)
 
 	case error:
@@ -275,6 +281,11 @@ func (m *Model) enterClient(item clientslist.ClientsItem) tea.Cmd {
 		m.state = stateClient
 		m.clientModel = sonarr.New(m.client.Sonarr, m.availableWidth, m.availableHeight)
 		return m.clientModel.Init()
+
+	case "radarr":
+		m.state = stateClient
+		m.clientModel = radarr.New(m.client.Radarr, m.availableWidth, m.availableHeight)
+		return m.clientModel.Init()
 	}
 
 	return nil
@@ -1,6 +1,10 @@
 package radarr
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+
 	"github.com/jon4hz/submarr/internal/config"
 	"github.com/jon4hz/submarr/internal/httpclient"
 )
@@ -18,3 +22,246 @@ func New(httpClient httpclient.Client, cfg *config.RadarrConfig) *Client {
 		cfg:  cfg,
 	}
 }
+
+// Ping pings the radarr server
+func (c *Client) Ping(ctx context.Context) (*Ping, error) {
+	var res Ping
+	_, err := c.http.Get(ctx, c.cfg.Host, "/ping", &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetMovies returns a list of all movies
+func (c *Client) GetMovies(ctx context.Context) ([]*MovieResource, error) {
+	var res []*MovieResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/movie", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetMovie returns a movie by its ID
+func (c *Client) GetMovie(ctx context.Context, movieID int32) (*MovieResource, error) {
+	var res MovieResource
+	_, err := c.http.Get(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/movie/%d", movieID), &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// PutMovie updates a movie by its ID
+func (c *Client) PutMovie(ctx context.Context, movie *MovieResource, opts ...httpclient.RequestOpts) (*MovieResource, error) {
+	var res MovieResource
+	_, err := c.http.Put(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/movie/%d", movie.ID), &res, movie, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// PostMovie adds a new movie
+func (c *Client) PostMovie(ctx context.Context, movie *MovieResource) (*MovieResource, error) {
+	var res MovieResource
+	_, err := c.http.Post(ctx, c.cfg.Host, "/api/v3/movie", &res, movie)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteMovie deletes a movie by its ID
+func (c *Client) DeleteMovie(ctx context.Context, movieID int32, deleteFiles, addImportExclusion bool) error {
+	_, err := c.http.Delete(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/movie/%d", movieID), nil, nil,
+		httpclient.WithParams(map[string]string{
+			"deleteFiles":        strconv.FormatBool(deleteFiles),
+			"addImportExclusion": strconv.FormatBool(addImportExclusion),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetMovieLookup returns a list of movies matching the given query
+func (c *Client) GetMovieLookup(ctx context.Context, query string) ([]*MovieResource, error) {
+	var res []*MovieResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/movie/lookup", &res, httpclient.WithParams(map[string]string{"term": query}))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetMovieLookupTMDB returns the movie with the given TMDB ID
+func (c *Client) GetMovieLookupTMDB(ctx context.Context, tmdbID int32) (*MovieResource, error) {
+	var res MovieResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/movie/lookup/tmdb", &res, httpclient.WithParams(map[string]string{"tmdbId": fmt.Sprint(tmdbID)}))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetMovieLookupIMDB returns the movie with the given IMDB ID
+func (c *Client) GetMovieLookupIMDB(ctx context.Context, imdbID string) (*MovieResource, error) {
+	var res MovieResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/movie/lookup/imdb", &res, httpclient.WithParams(map[string]string{"imdbId": imdbID}))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetQueue returns the current download queue
+func (c *Client) GetQueue(ctx context.Context, opts ...httpclient.RequestOpts) (*QueueResourcePagingResource, error) {
+	var res QueueResourcePagingResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/queue", &res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetQueueDetails returns the queue for a certain movie
+func (c *Client) GetQueueDetails(ctx context.Context, movieID int32) ([]*QueueResource, error) {
+	var res []*QueueResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/queue/details", &res, httpclient.WithParams(map[string]string{"movieId": fmt.Sprint(movieID)}))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetHistory returns the history of an object
+func (c *Client) GetHistory(ctx context.Context, opts ...httpclient.RequestOpts) (*HistoryResourcePagingResource, error) {
+	var res HistoryResourcePagingResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/history", &res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetMovieHistory returns the history of a certain movie
+func (c *Client) GetMovieHistory(ctx context.Context, movieID int32) ([]*HistoryResource, error) {
+	var res []*HistoryResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/history/movie", &res, httpclient.WithParams(map[string]string{"movieId": fmt.Sprint(movieID)}))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetMissing returns all the missing movies
+func (c *Client) GetMissing(ctx context.Context, opts ...httpclient.RequestOpts) (*MovieResourcePagingResource, error) {
+	var res MovieResourcePagingResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/wanted/missing", &res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetQualityProfiles returns a list of all quality profiles
+func (c *Client) GetQualityProfiles(ctx context.Context) ([]*QualityProfileResource, error) {
+	var res []*QualityProfileResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/qualityprofile", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetQualityProfile returns a quality profile by its ID
+func (c *Client) GetQualityProfile(ctx context.Context, id int32) (*QualityProfileResource, error) {
+	var res QualityProfileResource
+	_, err := c.http.Get(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/qualityprofile/%d", id), &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetRootFolders returns all root folders
+func (c *Client) GetRootFolders(ctx context.Context) ([]*RootFolderResource, error) {
+	var res []*RootFolderResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/rootfolder", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetMovieFiles returns all movie files for a given movie
+func (c *Client) GetMovieFiles(ctx context.Context, movieID int32) ([]*MovieFileResource, error) {
+	var res []*MovieFileResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/moviefile", &res, httpclient.WithParams(map[string]string{"movieId": fmt.Sprint(movieID)}))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteMovieFile deletes a movie file by its ID
+func (c *Client) DeleteMovieFile(ctx context.Context, movieFileID int32) error {
+	_, err := c.http.Delete(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/moviefile/%d", movieFileID), nil, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PostCommand sends a command to radarr
+func (c *Client) PostCommand(ctx context.Context, params *CommandRequest) (*CommandResource, error) {
+	var res CommandResource
+	_, err := c.http.Post(ctx, c.cfg.Host, "/api/v3/command", &res, params)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetSystemStatus returns the version and the environment of radarr
+func (c *Client) GetSystemStatus(ctx context.Context) (*SystemResource, error) {
+	var res SystemResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/system/status", &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetHealth returns the current health issues
+func (c *Client) GetHealth(ctx context.Context) ([]*HealthResource, error) {
+	var res []*HealthResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/health", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetDiskSpace returns the disk space of all mounts radarr can access
+func (c *Client) GetDiskSpace(ctx context.Context) ([]*DiskSpaceResource, error) {
+	var res []*DiskSpaceResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/diskspace", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetTasks returns all scheduled tasks
+func (c *Client) GetTasks(ctx context.Context) ([]*TaskResource, error) {
+	var res []*TaskResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/system/task", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,375 @@
+package radarr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jon4hz/submarr/internal/config"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRadarrHost = "localhost:7878"
+
+type handlerFunc func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error)
+
+type testClient struct {
+	mock    bool
+	handler handlerFunc
+}
+
+func (c *testClient) do(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+	if c.mock {
+		return 0, errors.New("mocked")
+	}
+	if c.handler == nil {
+		return 0, errors.New("no handler")
+	}
+	return c.handler(ctx, base, endpoint, method, expRes, reqData, opts...)
+}
+
+func (c *testClient) Get(ctx context.Context, base, endpoint string, expRes any, opts ...httpclient.RequestOpts) (int, error) {
+	return c.do(ctx, base, endpoint, http.MethodGet, expRes, nil, opts...)
+}
+
+func (c *testClient) Post(ctx context.Context, base, endpoint string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+	return c.do(ctx, base, endpoint, http.MethodPost, expRes, reqData, opts...)
+}
+
+func (c *testClient) Put(ctx context.Context, base, endpoint string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+	return c.do(ctx, base, endpoint, http.MethodPut, expRes, reqData, opts...)
+}
+
+func (c *testClient) Delete(ctx context.Context, base, endpoint string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+	return c.do(ctx, base, endpoint, http.MethodDelete, expRes, reqData, opts...)
+}
+
+func mustFile(path string) []byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func TestRadarrClient(t *testing.T) {
+	h := &testClient{}
+	cfg := new(config.Config)
+	cfg.Radarr = &config.RadarrConfig{
+		ClientConfig: config.ClientConfig{
+			Host: testRadarrHost,
+		},
+	}
+	c := New(h, cfg.Radarr)
+
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/ping", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 0, len(opts))
+
+			err := json.Unmarshal(mustFile("testdata/ping.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		p, err := c.Ping(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "OK", p.Status)
+
+		h.mock = true
+		p, err = c.Ping(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, p)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/movie", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 0, len(opts))
+
+			err := json.Unmarshal(mustFile("testdata/movies.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		movies, err := c.GetMovies(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(movies))
+		assert.Equal(t, Announced, movies[1].Status)
+
+		h.mock = true
+		movies, err = c.GetMovies(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, movies)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/movie/1", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 0, len(opts))
+
+			err := json.Unmarshal(mustFile("testdata/movie.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		movie, err := c.GetMovie(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(335984), movie.TMDBID)
+		assert.Equal(t, "Bluray-1080p", movie.MovieFile.Quality.Quality.Name)
+		assert.Equal(t, "x264", movie.MovieFile.MediaInfo.VideoCodec)
+
+		h.mock = true
+		movie, err = c.GetMovie(context.Background(), 1)
+		assert.Error(t, err)
+		assert.Nil(t, movie)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/movie/lookup/tmdb", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Equal(t, 1, len(opts))
+
+			rExpected := &httpclient.Request{}
+			httpclient.WithParams(map[string]string{"tmdbId": "335984"})(rExpected)
+
+			rActual := &httpclient.Request{}
+			opts[0](rActual)
+
+			assert.Equal(t, rExpected, rActual)
+
+			err := json.Unmarshal(mustFile("testdata/movie.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		movie, err := c.GetMovieLookupTMDB(context.Background(), 335984)
+		assert.NoError(t, err)
+		assert.Equal(t, "tt1856101", movie.ImdbID)
+
+		h.mock = true
+		movie, err = c.GetMovieLookupTMDB(context.Background(), 335984)
+		assert.Error(t, err)
+		assert.Nil(t, movie)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/movie", endpoint)
+			assert.Equal(t, http.MethodPost, method)
+			assert.NotNil(t, reqData)
+
+			movie, ok := reqData.(*MovieResource)
+			assert.True(t, ok)
+			assert.Equal(t, int32(335984), movie.TMDBID)
+
+			movie.ID = 1
+			data, err := json.Marshal(movie)
+			assert.NoError(t, err)
+			err = json.Unmarshal(data, expRes)
+			assert.NoError(t, err)
+			return http.StatusCreated, nil
+		}
+		movie, err := c.PostMovie(context.Background(), &MovieResource{TMDBID: 335984})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), movie.ID)
+
+		h.mock = true
+		movie, err = c.PostMovie(context.Background(), &MovieResource{TMDBID: 335984})
+		assert.Error(t, err)
+		assert.Nil(t, movie)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/movie/1", endpoint)
+			assert.Equal(t, http.MethodDelete, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 1, len(opts))
+
+			rExpected := &httpclient.Request{}
+			httpclient.WithParams(map[string]string{
+				"deleteFiles":        "true",
+				"addImportExclusion": "false",
+			})(rExpected)
+
+			rActual := &httpclient.Request{}
+			opts[0](rActual)
+
+			assert.Equal(t, rExpected, rActual)
+			return http.StatusOK, nil
+		}
+		err := c.DeleteMovie(context.Background(), 1, true, false)
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.DeleteMovie(context.Background(), 1, true, false)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/command", endpoint)
+			assert.Equal(t, http.MethodPost, method)
+
+			cmd, ok := reqData.(*CommandRequest)
+			assert.True(t, ok)
+			assert.Equal(t, "MoviesSearch", cmd.Name)
+			assert.Equal(t, []int32{1}, cmd.MovieIDs)
+
+			err := json.Unmarshal([]byte(`{"id":42,"name":"MoviesSearch","status":"queued"}`), expRes)
+			assert.NoError(t, err)
+			return http.StatusCreated, nil
+		}
+		cmd, err := c.PostCommand(context.Background(), &CommandRequest{Name: "MoviesSearch", MovieIDs: []int32{1}})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(42), cmd.ID)
+		assert.Equal(t, CommandStatusQueued, cmd.Status)
+
+		h.mock = true
+		cmd, err = c.PostCommand(context.Background(), &CommandRequest{Name: "MoviesSearch", MovieIDs: []int32{1}})
+		assert.Error(t, err)
+		assert.Nil(t, cmd)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/system/status", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`{"appName":"Radarr","version":"4.0.0.1","appData":"/config","osName":"ubuntu","osVersion":"22.04","isDocker":true,"runtimeName":".NET","runtimeVersion":"6.0.13","startTime":"2023-06-01T12:00:00Z"}`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		status, err := c.GetSystemStatus(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, &SystemResource{
+			AppName:        "Radarr",
+			Version:        "4.0.0.1",
+			AppData:        "/config",
+			OsName:         "ubuntu",
+			OsVersion:      "22.04",
+			IsDocker:       true,
+			RuntimeName:    ".NET",
+			RuntimeVersion: "6.0.13",
+			StartTime:      time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+		}, status)
+
+		h.mock = true
+		status, err = c.GetSystemStatus(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, status)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/health", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`[{"source":"IndexerStatusCheck","type":"warning","message":"Indexers unavailable due to failures","wikiUrl":"https://wiki.servarr.com/#indexers-are-unavailable-due-to-failures"}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		health, err := c.GetHealth(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*HealthResource{{
+			Source:  "IndexerStatusCheck",
+			Type:    HealthCheckWarning,
+			Message: "Indexers unavailable due to failures",
+			WikiURL: "https://wiki.servarr.com/#indexers-are-unavailable-due-to-failures",
+		}}, health)
+
+		h.mock = true
+		health, err = c.GetHealth(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, health)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/diskspace", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`[{"path":"/data","label":"data","freeSpace":1024,"totalSpace":4096}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		disks, err := c.GetDiskSpace(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*DiskSpaceResource{{Path: "/data", Label: "data", FreeSpace: 1024, TotalSpace: 4096}}, disks)
+
+		h.mock = true
+		disks, err = c.GetDiskSpace(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, disks)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testRadarrHost, base)
+			assert.Equal(t, "/api/v3/system/task", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`[{"id":1,"name":"Backup","taskName":"Backup","interval":10080,"lastExecution":"2023-06-01T12:00:00Z","nextExecution":"2023-06-08T12:00:00Z"}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		tasks, err := c.GetTasks(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*TaskResource{{
+			ID:            1,
+			Name:          "Backup",
+			TaskName:      "Backup",
+			Interval:      10080,
+			LastExecution: time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+			NextExecution: time.Date(2023, 6, 8, 12, 0, 0, 0, time.UTC),
+		}}, tasks)
+
+		h.mock = true
+		tasks, err = c.GetTasks(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, tasks)
+		h.mock = false
+	}
+}
+
+func TestTimeLeftJson(t *testing.T) {
+	tlRaw, err := time.Parse("15:04:05", "04:20:59")
+	assert.NoError(t, err)
+	tl := TimeLeft(tlRaw)
+
+	data, err := tl.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"04:20:59"`, string(data))
+
+	var tl2 TimeLeft
+	err = tl2.UnmarshalJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, tl, tl2)
+
+	var tl3 TimeLeft
+	err = tl3.UnmarshalJSON([]byte(`"invalid"`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,535 @@
+package radarr
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jon4hz/submarr/internal/httpclient"
+)
+
+// Ping is the response from the ping endpoint
+type Ping struct {
+	Status string `json:"status"`
+}
+
+// MovieResource is the response from the movie endpoint
+type MovieResource struct {
+	ID                    int32                      `json:"id"`
+	Title                 string                     `json:"title"`
+	OriginalTitle         string                     `json:"originalTitle"`
+	OriginalLanguage      *Language                  `json:"originalLanguage"`
+	AlternateTitles       []AlternativeTitleResource `json:"alternateTitles"`
+	SecondaryYear         int32                      `json:"secondaryYear"`
+	SecondaryYearSourceID int32                      `json:"secondaryYearSourceId"`
+	SortTitle             string                     `json:"sortTitle"`
+	SizeOnDisk            int64                      `json:"sizeOnDisk"`
+	Status                MovieStatusType            `json:"status"`
+	Overview              string                     `json:"overview"`
+	InCinemas             time.Time                  `json:"inCinemas"`
+	PhysicalRelease       time.Time                  `json:"physicalRelease"`
+	DigitalRelease        time.Time                  `json:"digitalRelease"`
+	PhysicalReleaseNote   string                     `json:"physicalReleaseNote"`
+	Images                []MediaCover               `json:"images"`
+	Website               string                     `json:"website"`
+	RemotePoster          string                     `json:"remotePoster"`
+	Year                  int32                      `json:"year"`
+	YouTubeTrailerID      string                     `json:"youTubeTrailerId"`
+	Studio                string                     `json:"studio"`
+	Path                  string                     `json:"path"`
+	QualityProfileID      int32                      `json:"qualityProfileId"`
+	ProfileName           string                     `json:"profileName"`
+	HasFile               bool                       `json:"hasFile"`
+	MovieFileID           int32                      `json:"movieFileId"`
+	Monitored             bool                       `json:"monitored"`
+	MinimumAvailability   MovieStatusType            `json:"minimumAvailability"`
+	IsAvailable           bool                       `json:"isAvailable"`
+	FolderName            string                     `json:"folderName"`
+	Runtime               int32                      `json:"runtime"`
+	CleanTitle            string                     `json:"cleanTitle"`
+	ImdbID                string                     `json:"imdbId"`
+	TMDBID                int32                      `json:"tmdbId"`
+	TitleSlug             string                     `json:"titleSlug"`
+	RootFolderPath        string                     `json:"rootFolderPath"`
+	Folder                string                     `json:"folder"`
+	Certification         string                     `json:"certification"`
+	Genres                []string                   `json:"genres"`
+	Tags                  []int32                    `json:"tags"`
+	Added                 time.Time                  `json:"added"`
+	AddOptions            *AddMovieOptions           `json:"addOptions"`
+	Ratings               *Ratings                   `json:"ratings"`
+	MovieFile             *MovieFileResource         `json:"movieFile"`
+	Collection            *MovieCollectionResource   `json:"collection"`
+	Popularity            float64                    `json:"popularity"`
+	Statistics            *MovieStatisticsResource   `json:"statistics"`
+}
+
+type AlternativeTitleResource struct {
+	ID         int32  `json:"id"`
+	SourceType string `json:"sourceType"`
+	MovieID    int32  `json:"movieMetadataId"`
+	Title      string `json:"title"`
+	CleanTitle string `json:"cleanTitle"`
+}
+
+type MovieStatusType string
+
+const (
+	TBA       MovieStatusType = "tba"
+	Announced MovieStatusType = "announced"
+	InCinemas MovieStatusType = "inCinemas"
+	Released  MovieStatusType = "released"
+	Deleted   MovieStatusType = "deleted"
+)
+
+type MediaCover struct {
+	CoverType MediaCoverType `json:"coverType"`
+	URL       string         `json:"url"`
+	RemoteURL string         `json:"remoteUrl"`
+}
+
+type MediaCoverType string
+
+const (
+	UnknownMediaCoverType MediaCoverType = "unknown"
+	Poster                MediaCoverType = "poster"
+	Banner                MediaCoverType = "banner"
+	Fanart                MediaCoverType = "fanart"
+	Screenshot            MediaCoverType = "screenshot"
+	Headshot              MediaCoverType = "headshot"
+	Clearlogo             MediaCoverType = "clearlogo"
+)
+
+type Language struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+type AddMovieOptions struct {
+	IgnoreEpisodesWithFiles    bool        `json:"ignoreEpisodesWithFiles"`
+	IgnoreEpisodesWithoutFiles bool        `json:"ignoreEpisodesWithoutFiles"`
+	Monitor                    MonitorType `json:"monitor"`
+	SearchForMovie             bool        `json:"searchForMovie"`
+	AddMethod                  AddMethod   `json:"addMethod"`
+}
+
+type MonitorType string
+
+const (
+	MovieOnly          MonitorType = "movieOnly"
+	MovieAndCollection MonitorType = "movieAndCollection"
+	None               MonitorType = "none"
+)
+
+type AddMethod string
+
+const (
+	AddMethodManual     AddMethod = "manual"
+	AddMethodList       AddMethod = "list"
+	AddMethodCollection AddMethod = "collection"
+)
+
+type Ratings struct {
+	IMDB           *RatingChild `json:"imdb"`
+	TMDB           *RatingChild `json:"tmdb"`
+	Metacritic     *RatingChild `json:"metacritic"`
+	RottenTomatoes *RatingChild `json:"rottenTomatoes"`
+}
+
+type RatingChild struct {
+	Votes int32   `json:"votes"`
+	Value float64 `json:"value"`
+	Type  string  `json:"type"`
+}
+
+type MovieCollectionResource struct {
+	Title  string `json:"title"`
+	TMDBID int32  `json:"tmdbId"`
+}
+
+type MovieStatisticsResource struct {
+	MovieFileCount int32    `json:"movieFileCount"`
+	SizeOnDisk     int64    `json:"sizeOnDisk"`
+	ReleaseGroups  []string `json:"releaseGroups"`
+}
+
+type MovieFileResource struct {
+	ID                  int32                  `json:"id"`
+	MovieID             int32                  `json:"movieId"`
+	RelativePath        string                 `json:"relativePath"`
+	Path                string                 `json:"path"`
+	Size                int64                  `json:"size"`
+	DateAdded           time.Time              `json:"dateAdded"`
+	SceneName           string                 `json:"sceneName"`
+	ReleaseGroup        string                 `json:"releaseGroup"`
+	Edition             string                 `json:"edition"`
+	Languages           []Language             `json:"languages"`
+	Quality             *QualityModel          `json:"quality"`
+	CustomFormats       []CustomFormatResource `json:"customFormats"`
+	CustomFormatScore   int32                  `json:"customFormatScore"`
+	IndexerFlags        int32                  `json:"indexerFlags"`
+	MediaInfo           *MediaInfoResource     `json:"mediaInfo"`
+	OriginalFilePath    string                 `json:"originalFilePath"`
+	QualityCutoffNotMet bool                   `json:"qualityCutoffNotMet"`
+}
+
+type QualityModel struct {
+	Quality  *Quality  `json:"quality"`
+	Revision *Revision `json:"revision"`
+}
+
+type Quality struct {
+	ID         int32         `json:"id"`
+	Name       string        `json:"name"`
+	Source     QualitySource `json:"source"`
+	Resolution int32         `json:"resolution"`
+	Modifier   string        `json:"modifier"`
+}
+
+type QualitySource string
+
+const (
+	UnknownQualitySource QualitySource = "unknown"
+	Cam                  QualitySource = "cam"
+	Telesync             QualitySource = "telesync"
+	Telecine             QualitySource = "telecine"
+	Workprint            QualitySource = "workprint"
+	Dvd                  QualitySource = "dvd"
+	TV                   QualitySource = "tv"
+	WebDL                QualitySource = "webdl"
+	WebRip               QualitySource = "webrip"
+	Bluray               QualitySource = "bluray"
+)
+
+type Revision struct {
+	Version  int32 `json:"version"`
+	Real     int32 `json:"real"`
+	IsRepack bool  `json:"isRepack"`
+}
+
+type CustomFormatResource struct {
+	ID                              int32  `json:"id"`
+	Name                            string `json:"name"`
+	IncludeCustomFormatWhenRenaming bool   `json:"includeCustomFormatWhenRenaming"`
+}
+
+type MediaInfoResource struct {
+	ID                    int32   `json:"id"`
+	AudioBitrate          int64   `json:"audioBitrate"`
+	AudioChannels         float64 `json:"audioChannels"`
+	AudioCodec            string  `json:"audioCodec"`
+	AudioLanguages        string  `json:"audioLanguages"`
+	AudioStreamCount      int32   `json:"audioStreamCount"`
+	VideoBitDepth         int32   `json:"videoBitDepth"`
+	VideoBitrate          int64   `json:"videoBitrate"`
+	VideoCodec            string  `json:"videoCodec"`
+	VideoFps              float64 `json:"videoFps"`
+	VideoDynamicRange     string  `json:"videoDynamicRange"`
+	VideoDynamicRangeType string  `json:"videoDynamicRangeType"`
+	Resolution            string  `json:"resolution"`
+	RunTime               string  `json:"runTime"`
+	ScanType              string  `json:"scanType"`
+	Subtitles             string  `json:"subtitles"`
+}
+
+type QueueResourcePagingResource struct {
+	Page          int32                    `json:"page"`
+	PageSize      int32                    `json:"pageSize"`
+	SortKey       string                   `json:"sortKey"`
+	SortDirection httpclient.SortDirection `json:"sortDirection"`
+	Filters       []PagingResourceFilter   `json:"filters"`
+	TotalRecords  int32                    `json:"totalRecords"`
+	Records       []*QueueResource         `json:"records"`
+}
+
+type PagingResourceFilter struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type QueueResource struct {
+	ID                      int32                          `json:"id"`
+	MovieID                 int32                          `json:"movieId"`
+	Movie                   *MovieResource                 `json:"movie"`
+	Languages               []Language                     `json:"languages"`
+	Quality                 *QualityModel                  `json:"quality"`
+	CustomFormats           []CustomFormatResource         `json:"customFormats"`
+	CustomFormatScore       int32                          `json:"customFormatScore"`
+	Size                    float64                        `json:"size"`
+	Title                   string                         `json:"title"`
+	Sizeleft                float64                        `json:"sizeleft"`
+	Timeleft                TimeLeft                       `json:"timeleft"`
+	EstimatedCompletionTime time.Time                      `json:"estimatedCompletionTime"`
+	Status                  string                         `json:"status"`
+	TrackedDownloadStatus   TrackedDownloadStatus          `json:"trackedDownloadStatus"`
+	TrackedDownloadState    TrackedDownloadState           `json:"trackedDownloadState"`
+	StatusMessages          []TrackedDownloadStatusMessage `json:"statusMessages"`
+	ErrorMessage            string                         `json:"errorMessage"`
+	DownloadID              string                         `json:"downloadId"`
+	Protocol                DownloadProtocol               `json:"protocol"`
+	DownloadClient          string                         `json:"downloadClient"`
+	Indexer                 string                         `json:"indexer"`
+	OutputPath              string                         `json:"outputPath"`
+}
+
+// TimeLeft is a custom type to handle the timeleft field
+type TimeLeft time.Time
+
+func (tl *TimeLeft) UnmarshalJSON(b []byte) (err error) {
+	value := strings.Trim(string(b), `"`) // get rid of "
+	if value == "" || value == "null" {
+		return nil
+	}
+
+	t, err := time.Parse("15:04:05", value) // parse time
+	if err != nil {
+		return err
+	}
+	*tl = TimeLeft(t) // set result using the pointer
+	return nil
+}
+
+func (tl TimeLeft) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + time.Time(tl).Format("15:04:05") + `"`), nil
+}
+
+type TrackedDownloadStatus string
+
+const (
+	OK      TrackedDownloadStatus = "ok"
+	Warning TrackedDownloadStatus = "warning"
+	Error   TrackedDownloadStatus = "error"
+)
+
+type TrackedDownloadState string
+
+const (
+	Downloading   TrackedDownloadState = "downloading"
+	ImportPending TrackedDownloadState = "importPending"
+	Importing     TrackedDownloadState = "importing"
+	Imported      TrackedDownloadState = "imported"
+	FailedPending TrackedDownloadState = "failedPending"
+	Failed        TrackedDownloadState = "failed"
+	Ignored       TrackedDownloadState = "ignored"
+)
+
+type TrackedDownloadStatusMessage struct {
+	Title    string   `json:"title"`
+	Messages []string `json:"messages"`
+}
+
+type DownloadProtocol string
+
+const (
+	UnknownDownloadProtocol DownloadProtocol = "unknown"
+	Usenet                  DownloadProtocol = "usenet"
+	Torrent                 DownloadProtocol = "torrent"
+)
+
+type QualityProfileResource struct {
+	ID                int32                               `json:"id"`
+	Name              string                              `json:"name"`
+	UpgradeAllowed    bool                                `json:"upgradeAllowed"`
+	Cutoff            int32                               `json:"cutoff"`
+	Items             []QualityProfileQualityItemResource `json:"items"`
+	MinFormatScore    int32                               `json:"minFormatScore"`
+	CutoffFormatScore int32                               `json:"cutoffFormatScore"`
+	FormatItems       []ProfileFormatItemResource         `json:"formatItems"`
+	Language          *Language                           `json:"language"`
+}
+
+type QualityProfileQualityItemResource struct {
+	ID      int32   `json:"id"`
+	Name    string  `json:"name"`
+	Quality Quality `json:"quality"`
+	Items   []any   `json:"items"`
+	Allowed bool    `json:"allowed"`
+}
+
+type ProfileFormatItemResource struct {
+	ID     int32  `json:"id"`
+	Format int32  `json:"format"`
+	Name   string `json:"name"`
+	Score  int32  `json:"score"`
+}
+
+type MovieResourcePagingResource struct {
+	Page          int32                    `json:"page"`
+	PageSize      int32                    `json:"pageSize"`
+	SortKey       string                   `json:"sortKey"`
+	SortDirection httpclient.SortDirection `json:"sortDirection"`
+	Filters       []PagingResourceFilter   `json:"filters"`
+	TotalRecords  int32                    `json:"totalRecords"`
+	Records       []*MovieResource         `json:"records"`
+}
+
+type HistoryResourcePagingResource struct {
+	Page          int32                    `json:"page"`
+	PageSize      int32                    `json:"pageSize"`
+	SortKey       string                   `json:"sortKey"`
+	SortDirection httpclient.SortDirection `json:"sortDirection"`
+	Filters       []PagingResourceFilter   `json:"filters"`
+	TotalRecords  int32                    `json:"totalRecords"`
+	Records       []*HistoryResource       `json:"records"`
+}
+
+type HistoryResource struct {
+	ID                  int32                  `json:"id"`
+	MovieID             int32                  `json:"movieId"`
+	SourceTitle         string                 `json:"sourceTitle"`
+	Languages           []Language             `json:"languages"`
+	Quality             *QualityModel          `json:"quality"`
+	CustomFormats       []CustomFormatResource `json:"customFormats"`
+	CustomFormatScore   int32                  `json:"customFormatScore"`
+	QualityCutoffNotMet bool                   `json:"qualityCutoffNotMet"`
+	Date                time.Time              `json:"date"`
+	DownloadID          string                 `json:"downloadId"`
+	EventType           MovieHistoryEventType  `json:"eventType"`
+	Data                map[string]string      `json:"data"`
+	Movie               *MovieResource         `json:"movie"`
+}
+
+type MovieHistoryEventType string
+
+const (
+	MovieHistoryEventTypeUnknown                MovieHistoryEventType = "unknown"
+	MovieHistoryEventTypeGrabbed                MovieHistoryEventType = "grabbed"
+	MovieHistoryEventTypeDownloadFolderImported MovieHistoryEventType = "downloadFolderImported"
+	MovieHistoryEventTypeDownloadFailed         MovieHistoryEventType = "downloadFailed"
+	MovieHistoryEventTypeMovieFileDeleted       MovieHistoryEventType = "movieFileDeleted"
+	MovieHistoryEventTypeMovieFolderImported    MovieHistoryEventType = "movieFolderImported"
+	MovieHistoryEventTypeMovieFileRenamed       MovieHistoryEventType = "movieFileRenamed"
+	MovieHistoryEventTypeDownloadIgnored        MovieHistoryEventType = "downloadIgnored"
+)
+
+type RootFolderResource struct {
+	ID              int32            `json:"id"`
+	Path            string           `json:"path"`
+	Accessible      bool             `json:"accessible"`
+	FreeSpace       int64            `json:"freeSpace"`
+	UnmappedFolders []UnmappedFolder `json:"unmappedFolders"`
+}
+
+type UnmappedFolder struct {
+	Name         string `json:"name"`
+	Path         string `json:"path"`
+	RelativePath string `json:"relativePath"`
+}
+
+type CommandResource struct {
+	ID                  int32           `json:"id"`
+	Name                string          `json:"name"`
+	CommandName         string          `json:"commandName"`
+	Message             string          `json:"message"`
+	Priority            CommandPriority `json:"priority"`
+	Status              CommandStatus   `json:"status"`
+	Result              CommandResult   `json:"result"`
+	Queued              time.Time       `json:"queued"`
+	Started             time.Time       `json:"started"`
+	Ended               time.Time       `json:"ended"`
+	Exception           string          `json:"exception"`
+	Trigger             CommandTrigger  `json:"trigger"`
+	ClientUserAgent     string          `json:"clientUserAgent"`
+	StateChangeTime     time.Time       `json:"stateChangeTime"`
+	SendUpdatesToClient bool            `json:"sendUpdatesToClient"`
+	UpdateScheduledTask bool            `json:"updateScheduledTask"`
+	LastExecutionTime   time.Time       `json:"lastExecutionTime"`
+}
+
+type CommandTrigger string
+
+const (
+	CommandTriggerUnspecified CommandTrigger = "unspecified"
+	CommandTriggerManual      CommandTrigger = "manual"
+	CommandTriggerScheduled   CommandTrigger = "scheduled"
+)
+
+type CommandPriority string
+
+const (
+	CommandPriorityNormal CommandPriority = "normal"
+	CommandPriorityHigh   CommandPriority = "high"
+	CommandPriorityLow    CommandPriority = "low"
+)
+
+type CommandStatus string
+
+const (
+	CommandStatusQueued    CommandStatus = "queued"
+	CommandStatusStarted   CommandStatus = "started"
+	CommandStatusCompleted CommandStatus = "completed"
+	CommandStatusFailed    CommandStatus = "failed"
+	CommandStatusAborted   CommandStatus = "aborted"
+	CommandStatusCancelled CommandStatus = "cancelled"
+	CommandStatusOrphaned  CommandStatus = "orphaned"
+)
+
+type CommandResult string
+
+const (
+	CommandResultUnknown     CommandResult = "unknown"
+	CommandResultSuccessful  CommandResult = "successful"
+	CommandResultUnsucessful CommandResult = "unsuccessful"
+)
+
+// CommandRequest is the request body for a command
+type CommandRequest struct {
+	Name     string  `json:"name"`
+	MovieIDs []int32 `json:"movieIds,omitempty"`
+}
+
+// SystemResource is the response from the system status endpoint
+type SystemResource struct {
+	AppName          string    `json:"appName"`
+	InstanceName     string    `json:"instanceName"`
+	Version          string    `json:"version"`
+	BuildTime        time.Time `json:"buildTime"`
+	StartupPath      string    `json:"startupPath"`
+	AppData          string    `json:"appData"`
+	OsName           string    `json:"osName"`
+	OsVersion        string    `json:"osVersion"`
+	IsDocker         bool      `json:"isDocker"`
+	Branch           string    `json:"branch"`
+	RuntimeName      string    `json:"runtimeName"`
+	RuntimeVersion   string    `json:"runtimeVersion"`
+	StartTime        time.Time `json:"startTime"`
+	DatabaseType     string    `json:"databaseType"`
+	DatabaseVersion  string    `json:"databaseVersion"`
+	MigrationVersion int32     `json:"migrationVersion"`
+}
+
+type HealthResource struct {
+	ID      int32           `json:"id"`
+	Source  string          `json:"source"`
+	Type    HealthCheckType `json:"type"`
+	Message string          `json:"message"`
+	WikiURL string          `json:"wikiUrl"`
+}
+
+type HealthCheckType string
+
+const (
+	HealthCheckOk      HealthCheckType = "ok"
+	HealthCheckNotice  HealthCheckType = "notice"
+	HealthCheckWarning HealthCheckType = "warning"
+	HealthCheckError   HealthCheckType = "error"
+)
+
+type DiskSpaceResource struct {
+	ID         int32  `json:"id"`
+	Path       string `json:"path"`
+	Label      string `json:"label"`
+	FreeSpace  int64  `json:"freeSpace"`
+	TotalSpace int64  `json:"totalSpace"`
+}
+
+// TaskResource is a scheduled task of radarr.
+// The interval is in minutes, the task name can be sent as command to run the task.
+type TaskResource struct {
+	ID            int32     `json:"id"`
+	Name          string    `json:"name"`
+	TaskName      string    `json:"taskName"`
+	Interval      int32     `json:"interval"`
+	LastExecution time.Time `json:"lastExecution"`
+	LastStartTime time.Time `json:"lastStartTime"`
+	NextExecution time.Time `json:"nextExecution"`
+}
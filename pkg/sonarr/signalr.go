@@ -0,0 +1,235 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/jon4hz/submarr/internal/httpclient"
+)
+
+// The SignalR hub protocol is documented here:
+// https://github.com/dotnet/aspnetcore/blob/main/src/SignalR/docs/specs/HubProtocol.md
+const (
+	signalRInvocation = 1
+	signalRPing       = 6
+	signalRClose      = 7
+
+	// every message sent over the hub is terminated by the ascii record separator
+	signalRRecordSeparator = 0x1e
+
+	// sonarr sends a ping every 15 seconds, if we don't receive anything for longer, the connection is dead
+	signalRReadTimeout = 60 * time.Second
+)
+
+// SignalR event names pushed by sonarr
+const (
+	SignalREventSeries       = "series"
+	SignalREventEpisode      = "episode"
+	SignalREventEpisodeFile  = "episodefile"
+	SignalREventQueue        = "queue"
+	SignalREventQueueDetails = "queue/details"
+	SignalREventQueueStatus  = "queue/status"
+	SignalREventCommand      = "command"
+	SignalREventHealth       = "health"
+	SignalREventSystemTask   = "system/task"
+)
+
+var ErrSignalRHandshake = errors.New("signalr handshake failed")
+
+// SignalRMessage is an event pushed by sonarr over the signalr hub
+type SignalRMessage struct {
+	Name string             `json:"name"`
+	Body SignalRMessageBody `json:"body"`
+}
+
+type SignalRMessageBody struct {
+	// Action is usually one of "updated", "deleted" or "sync"
+	Action   string          `json:"action"`
+	Resource json.RawMessage `json:"resource"`
+}
+
+type signalRFrame struct {
+	Type      int               `json:"type"`
+	Target    string            `json:"target,omitempty"`
+	Arguments []json.RawMessage `json:"arguments,omitempty"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// SignalRConn is a connection to the signalr hub of sonarr
+type SignalRConn struct {
+	conn *websocket.Conn
+	// a single websocket message can contain multiple events
+	pending []*SignalRMessage
+}
+
+// ConnectSignalR connects to the signalr hub of sonarr and performs the handshake.
+// The negotiation is skipped, since we only support websockets anyway.
+func (c *Client) ConnectSignalR(ctx context.Context) (*SignalRConn, error) {
+	u, err := url.Parse(c.cfg.Host)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "https" {
+		u.Scheme = "wss"
+	} else {
+		u.Scheme = "ws"
+	}
+	u.Path = "/signalr/messages"
+	u.RawQuery = url.Values{"access_token": {c.cfg.APIKey}}.Encode()
+
+	header := http.Header{}
+	if c.cfg.APIKey != "" {
+		header.Set("X-Api-Key", c.cfg.APIKey)
+	}
+	for _, v := range c.cfg.HeaderConfigs {
+		header.Add(v.Key, v.Value)
+	}
+	if c.cfg.BasicAuth != nil {
+		// use a request to encode the credentials the same way net/http does
+		req := http.Request{Header: header}
+		req.SetBasicAuth(c.cfg.BasicAuth.Username, c.cfg.BasicAuth.Password)
+	}
+
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 30 * time.Second,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: c.cfg.IgnoreTLS,
+		},
+	}
+	conn, resp, err := dialer.DialContext(ctx, u.String(), header)
+	if err != nil {
+		if resp != nil {
+			switch resp.StatusCode {
+			case http.StatusUnauthorized:
+				return nil, httpclient.ErrUnauthorized
+			case http.StatusNotFound:
+				return nil, httpclient.ErrNotFound
+			}
+		}
+		return nil, err
+	}
+
+	s := &SignalRConn{conn: conn}
+	if err := s.handshake(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *SignalRConn) handshake() error {
+	if err := s.write(map[string]any{"protocol": "json", "version": 1}); err != nil {
+		return err
+	}
+
+	frames, err := s.read()
+	if err != nil {
+		return err
+	}
+	// the handshake response is an empty object if everything went fine
+	var res struct {
+		Error string `json:"error"`
+	}
+	if len(frames) == 0 {
+		return ErrSignalRHandshake
+	}
+	if err := json.Unmarshal(frames[0], &res); err != nil {
+		return err
+	}
+	if res.Error != "" {
+		return fmt.Errorf("%w: %s", ErrSignalRHandshake, res.Error)
+	}
+
+	// the server might have sent some messages right after the handshake
+	return s.handleFrames(frames[1:])
+}
+
+// Read blocks until the next event is received.
+// Pings are answered automatically.
+func (s *SignalRConn) Read() (*SignalRMessage, error) {
+	for len(s.pending) == 0 {
+		frames, err := s.read()
+		if err != nil {
+			return nil, err
+		}
+		if err := s.handleFrames(frames); err != nil {
+			return nil, err
+		}
+	}
+	msg := s.pending[0]
+	s.pending = s.pending[1:]
+	return msg, nil
+}
+
+func (s *SignalRConn) handleFrames(frames [][]byte) error {
+	for _, raw := range frames {
+		var frame signalRFrame
+		if err := json.Unmarshal(raw, &frame); err != nil {
+			return err
+		}
+		switch frame.Type {
+		case signalRInvocation:
+			if frame.Target != "receiveMessage" || len(frame.Arguments) == 0 {
+				continue
+			}
+			var msg SignalRMessage
+			if err := json.Unmarshal(frame.Arguments[0], &msg); err != nil {
+				return err
+			}
+			s.pending = append(s.pending, &msg)
+
+		case signalRPing:
+			// answer the ping, otherwise the server closes the connection after a while
+			if err := s.write(signalRFrame{Type: signalRPing}); err != nil {
+				return err
+			}
+
+		case signalRClose:
+			if frame.Error != "" {
+				return fmt.Errorf("signalr connection closed: %s", frame.Error)
+			}
+			return errors.New("signalr connection closed")
+		}
+	}
+	return nil
+}
+
+// read reads the next websocket message and splits it into frames
+func (s *SignalRConn) read() ([][]byte, error) {
+	if err := s.conn.SetReadDeadline(time.Now().Add(signalRReadTimeout)); err != nil {
+		return nil, err
+	}
+	_, data, err := s.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	var frames [][]byte
+	for _, frame := range bytes.Split(data, []byte{signalRRecordSeparator}) {
+		if len(frame) > 0 {
+			frames = append(frames, frame)
+		}
+	}
+	return frames, nil
+}
+
+func (s *SignalRConn) write(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.conn.WriteMessage(websocket.TextMessage, append(data, signalRRecordSeparator))
+}
+
+// Close closes the connection to the hub
+func (s *SignalRConn) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,124 @@
+package sonarr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/jon4hz/submarr/internal/config"
+	"github.com/jon4hz/submarr/internal/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+const rs = "\x1e"
+
+// newFakeHub starts a server which behaves like the signalr hub of sonarr.
+// After the handshake, the messages are sent to the client one by one.
+func newFakeHub(t *testing.T, messages ...string) (*httptest.Server, chan string) {
+	received := make(chan string, 10)
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/signalr/messages" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("access_token") != "secret" || r.Header.Get("X-Api-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, handshake, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		received <- string(handshake)
+		if err := conn.WriteMessage(websocket.TextMessage, []byte("{}"+rs)); err != nil {
+			return
+		}
+
+		for _, msg := range messages {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+				return
+			}
+			if strings.Contains(msg, `"type":6`) {
+				_, pong, err := conn.ReadMessage()
+				if err != nil {
+					return
+				}
+				received <- string(pong)
+			}
+		}
+		// keep the connection open until the client is gone
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, received
+}
+
+func newSignalRClient(host, apiKey string) *Client {
+	cfg := &config.SonarrConfig{}
+	cfg.Host = host
+	cfg.APIKey = apiKey
+	return New(httpclient.New(), cfg)
+}
+
+func TestSignalR(t *testing.T) {
+	srv, received := newFakeHub(t,
+		`{"type":1,"target":"receiveMessage","arguments":[{"name":"queue","body":{"action":"sync"}}]}`+rs+
+			`{"type":1,"target":"receiveMessage","arguments":[{"name":"command","body":{"action":"updated","resource":{"id":3,"name":"SeriesSearch","status":"completed"}}}]}`+rs,
+		`{"type":6}`+rs,
+		`{"type":1,"target":"receiveMessage","arguments":[{"name":"series","body":{"action":"deleted","resource":{"id":1}}}]}`+rs,
+		`{"type":7,"error":"server shutdown"}`+rs,
+	)
+
+	c := newSignalRClient(srv.URL, "secret")
+	conn, err := c.ConnectSignalR(context.Background())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	assert.Equal(t, `{"protocol":"json","version":1}`+rs, <-received)
+
+	msg, err := conn.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, SignalREventQueue, msg.Name)
+	assert.Equal(t, "sync", msg.Body.Action)
+
+	msg, err = conn.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, SignalREventCommand, msg.Name)
+	assert.Equal(t, "updated", msg.Body.Action)
+	assert.JSONEq(t, `{"id":3,"name":"SeriesSearch","status":"completed"}`, string(msg.Body.Resource))
+
+	// the ping is answered while waiting for the next event
+	msg, err = conn.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, SignalREventSeries, msg.Name)
+	assert.Equal(t, "deleted", msg.Body.Action)
+	assert.Equal(t, `{"type":6}`+rs, <-received)
+
+	msg, err = conn.Read()
+	assert.ErrorContains(t, err, "server shutdown")
+	assert.Nil(t, msg)
+}
+
+func TestSignalRUnauthorized(t *testing.T) {
+	srv, _ := newFakeHub(t)
+
+	c := newSignalRClient(srv.URL, "wrong")
+	conn, err := c.ConnectSignalR(context.Background())
+	assert.ErrorIs(t, err, httpclient.ErrUnauthorized)
+	assert.Nil(t, conn)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/jon4hz/submarr/internal/config"
 	"github.com/jon4hz/submarr/internal/httpclient"
@@ -83,6 +84,22 @@ func (c *Client) DeleteSerie(ctx context.Context, serieID int32, opts ...httpcli
 	return nil
 }
 
+// PutSeriesEditor applies the changes of the editor to all given series
+func (c *Client) PutSeriesEditor(ctx context.Context, editor *SeriesEditorResource) ([]*SeriesResource, error) {
+	var res []*SeriesResource
+	_, err := c.http.Put(ctx, c.cfg.Host, "/api/v3/series/editor", &res, editor)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteSeriesEditor deletes all given series
+func (c *Client) DeleteSeriesEditor(ctx context.Context, editor *SeriesEditorResource) error {
+	_, err := c.http.Delete(ctx, c.cfg.Host, "/api/v3/series/editor", nil, editor)
+	return err
+}
+
 // GetQueue returns the current download queue
 func (c *Client) GetQueue(ctx context.Context, opts ...httpclient.RequestOpts) (*QueueResourcePagingResource, error) {
 	var res QueueResourcePagingResource
@@ -93,6 +110,29 @@ func (c *Client) GetQueue(ctx context.Context, opts ...httpclient.RequestOpts) (
 	return &res, nil
 }
 
+// DeleteQueueItem removes an item from the download queue
+func (c *Client) DeleteQueueItem(ctx context.Context, queueID int32, removeFromClient, blocklist bool) error {
+	_, err := c.http.Delete(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/queue/%d", queueID), nil, nil,
+		httpclient.WithParams(map[string]string{
+			"removeFromClient": strconv.FormatBool(removeFromClient),
+			"blocklist":        strconv.FormatBool(blocklist),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GrabQueueItem forces the download of a pending item in the queue
+func (c *Client) GrabQueueItem(ctx context.Context, queueID int32) error {
+	_, err := c.http.Post(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/queue/grab/%d", queueID), nil, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // GetEpisodes returns a list of episodes for a given series and season
 func (c *Client) GetEpisodes(ctx context.Context, seriesID, seasonNumber int32) ([]*EpisodeResource, error) {
 	params := map[string]string{
@@ -160,6 +200,12 @@ func (c *Client) SetEpisodesMonitored(ctx context.Context, params *EpisodesMonit
 	return err
 }
 
+// PostSeasonPass applies the monitoring options to all given series
+func (c *Client) PostSeasonPass(ctx context.Context, params *SeasonPassResource) error {
+	_, err := c.http.Post(ctx, c.cfg.Host, "/api/v3/seasonpass", nil, params)
+	return err
+}
+
 // PostCommand sends a command to sonarr
 func (c *Client) PostCommand(ctx context.Context, params *CommandRequest) (*CommandResource, error) {
 	var res CommandResource
@@ -170,10 +216,62 @@ func (c *Client) PostCommand(ctx context.Context, params *CommandRequest) (*Comm
 	return &res, nil
 }
 
-// GetQueueDetails returns the queue for a certain series
+// GetReleases searches the indexers for releases, use the params episodeId or seriesId and seasonNumber to specify what to search
+func (c *Client) GetReleases(ctx context.Context, opts ...httpclient.RequestOpts) ([]*ReleaseResource, error) {
+	var res []*ReleaseResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/release", &res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PostRelease grabs a release and sends it to the download client
+func (c *Client) PostRelease(ctx context.Context, release *ReleaseResource) (*ReleaseResource, error) {
+	var res ReleaseResource
+	_, err := c.http.Post(ctx, c.cfg.Host, "/api/v3/release", &res, release)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetCommand returns the current state of a command
+func (c *Client) GetCommand(ctx context.Context, commandID int32) (*CommandResource, error) {
+	var res CommandResource
+	_, err := c.http.Get(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/command/%d", commandID), &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetQueueDetails returns the queue for a certain series, or the whole queue if seriesID is 0
 func (c *Client) GetQueueDetails(ctx context.Context, seriesID int32) ([]*QueueResource, error) {
+	params := make(map[string]string)
+	if seriesID != 0 {
+		params["seriesId"] = fmt.Sprint(seriesID)
+	}
 	var res []*QueueResource
-	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/queue/details", &res, httpclient.WithParams(map[string]string{"seriesId": fmt.Sprint(seriesID)}))
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/queue/details", &res, httpclient.WithParams(params))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetCalendar returns the episodes airing between start and end, including their series
+func (c *Client) GetCalendar(ctx context.Context, start, end time.Time, unmonitored bool) ([]*EpisodeResource, error) {
+	params := map[string]string{
+		"start":         start.UTC().Format(time.RFC3339),
+		"end":           end.UTC().Format(time.RFC3339),
+		"unmonitored":   strconv.FormatBool(unmonitored),
+		"includeSeries": "true",
+	}
+	var res []*EpisodeResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/calendar", &res,
+		httpclient.WithParams(params),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -181,9 +279,19 @@ func (c *Client) GetQueueDetails(ctx context.Context, seriesID int32) ([]*QueueR
 }
 
 // GetMissings returns all the missing episodes
-func (c *Client) GetMissings(ctx context.Context) (*EpisodeResourcePagingResource, error) {
+func (c *Client) GetMissings(ctx context.Context, opts ...httpclient.RequestOpts) (*EpisodeResourcePagingResource, error) {
+	var res EpisodeResourcePagingResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/wanted/missing", &res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetCutoffUnmet returns all the episodes which don't meet the quality cutoff
+func (c *Client) GetCutoffUnmet(ctx context.Context, opts ...httpclient.RequestOpts) (*EpisodeResourcePagingResource, error) {
 	var res EpisodeResourcePagingResource
-	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/wanted/missing", &res)
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/wanted/cutoff", &res, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +318,102 @@ func (c *Client) GetRootFolders(ctx context.Context) ([]*RootFolderResource, err
 	return res, nil
 }
 
+// GetTags returns all tags
+func (c *Client) GetTags(ctx context.Context) ([]*TagResource, error) {
+	var res []*TagResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/tag", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetTag returns a tag by its ID
+func (c *Client) GetTag(ctx context.Context, tagID int32) (*TagResource, error) {
+	var res TagResource
+	_, err := c.http.Get(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/tag/%d", tagID), &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// PostTag creates a new tag
+func (c *Client) PostTag(ctx context.Context, label string) (*TagResource, error) {
+	var res TagResource
+	_, err := c.http.Post(ctx, c.cfg.Host, "/api/v3/tag", &res, &TagResource{Label: label})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// PutTag updates the label of a tag
+func (c *Client) PutTag(ctx context.Context, tag *TagResource) (*TagResource, error) {
+	var res TagResource
+	_, err := c.http.Put(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/tag/%d", tag.ID), &res, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteTag deletes a tag by its ID
+func (c *Client) DeleteTag(ctx context.Context, tagID int32) error {
+	_, err := c.http.Delete(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/tag/%d", tagID), nil, nil)
+	return err
+}
+
+// GetTagDetails returns all tags with the resources using them
+func (c *Client) GetTagDetails(ctx context.Context) ([]*TagDetailsResource, error) {
+	var res []*TagDetailsResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/tag/detail", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetTagDetail returns a tag with the resources using it
+func (c *Client) GetTagDetail(ctx context.Context, tagID int32) (*TagDetailsResource, error) {
+	var res TagDetailsResource
+	_, err := c.http.Get(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/tag/detail/%d", tagID), &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetRenamePreview returns the files of a series which don't match the naming config, use the params seriesId and seasonNumber to specify the files
+func (c *Client) GetRenamePreview(ctx context.Context, opts ...httpclient.RequestOpts) ([]*RenameEpisodeResource, error) {
+	var res []*RenameEpisodeResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/rename", &res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetManualImport returns the files which can be imported manually, use the params folder or downloadId to specify the files
+func (c *Client) GetManualImport(ctx context.Context, opts ...httpclient.RequestOpts) ([]*ManualImportResource, error) {
+	var res []*ManualImportResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/manualimport", &res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PostManualImportCommand starts the ManualImport command for the given files
+func (c *Client) PostManualImportCommand(ctx context.Context, params *ManualImportCommandRequest) (*CommandResource, error) {
+	var res CommandResource
+	_, err := c.http.Post(ctx, c.cfg.Host, "/api/v3/command", &res, params)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
 // GetLanguageProfiles returns all language profiles
 //
 // Deprecated: Will be obsolete in Sonarr v4
@@ -232,6 +436,54 @@ func (c *Client) GetHistory(ctx context.Context, opts ...httpclient.RequestOpts)
 	return res, nil
 }
 
+// GetHistorySince returns the history since the given date.
+// If eventType is empty, events of all types are returned.
+func (c *Client) GetHistorySince(ctx context.Context, date time.Time, eventType EpisodeHistoryEventType) ([]*HistoryResource, error) {
+	params := map[string]string{
+		"date":           date.UTC().Format(time.RFC3339),
+		"includeSeries":  "true",
+		"includeEpisode": "true",
+	}
+	if eventType != "" {
+		params["eventType"] = strconv.Itoa(eventType.ID())
+	}
+	var res []*HistoryResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/history/since", &res,
+		httpclient.WithParams(params),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSeriesHistory returns the history of a series.
+// If eventType is empty, events of all types are returned.
+func (c *Client) GetSeriesHistory(ctx context.Context, seriesID int32, eventType EpisodeHistoryEventType) ([]*HistoryResource, error) {
+	params := map[string]string{
+		"seriesId":       fmt.Sprint(seriesID),
+		"includeSeries":  "true",
+		"includeEpisode": "true",
+	}
+	if eventType != "" {
+		params["eventType"] = strconv.Itoa(eventType.ID())
+	}
+	var res []*HistoryResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/history/series", &res,
+		httpclient.WithParams(params),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarkHistoryFailed marks a grabbed release as failed
+func (c *Client) MarkHistoryFailed(ctx context.Context, historyID int32) error {
+	_, err := c.http.Post(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/history/failed/%d", historyID), nil, nil)
+	return err
+}
+
 // GetEpisodeFiles returns all episode files for a given series
 func (c *Client) GetEpisodeFiles(ctx context.Context, seriesID int32) ([]*EpisodeFileResource, error) {
 	var res []*EpisodeFileResource
@@ -250,3 +502,97 @@ func (c *Client) DeleteEpisodeFile(ctx context.Context, episodeFileID int32) err
 	}
 	return nil
 }
+
+// DeleteEpisodeFiles deletes multiple episode files at once
+func (c *Client) DeleteEpisodeFiles(ctx context.Context, episodeFileIDs []int32) error {
+	_, err := c.http.Delete(ctx, c.cfg.Host, "/api/v3/episodefile/bulk", nil, &EpisodeFileListResource{EpisodeFileIDs: episodeFileIDs})
+	return err
+}
+
+// PutEpisodeFileEditor changes the quality or the languages of multiple episode files
+func (c *Client) PutEpisodeFileEditor(ctx context.Context, editor *EpisodeFileListResource) error {
+	_, err := c.http.Put(ctx, c.cfg.Host, "/api/v3/episodefile/editor", nil, editor)
+	return err
+}
+
+// GetQualityDefinitions returns all qualities known to sonarr
+func (c *Client) GetQualityDefinitions(ctx context.Context) ([]*QualityDefinitionResource, error) {
+	var res []*QualityDefinitionResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/qualitydefinition", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetLanguages returns all languages known to sonarr
+func (c *Client) GetLanguages(ctx context.Context) ([]*Language, error) {
+	var res []*Language
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/language", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSystemStatus returns the version and the environment of sonarr
+func (c *Client) GetSystemStatus(ctx context.Context) (*SystemResource, error) {
+	var res SystemResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/system/status", &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetHealth returns the current health issues
+func (c *Client) GetHealth(ctx context.Context) ([]*HealthResource, error) {
+	var res []*HealthResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/health", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetDiskSpace returns the disk space of all mounts sonarr can access
+func (c *Client) GetDiskSpace(ctx context.Context) ([]*DiskSpaceResource, error) {
+	var res []*DiskSpaceResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/diskspace", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetTasks returns all scheduled tasks
+func (c *Client) GetTasks(ctx context.Context) ([]*TaskResource, error) {
+	var res []*TaskResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/system/task", &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetBlocklist returns a page of the blocklist
+func (c *Client) GetBlocklist(ctx context.Context, opts ...httpclient.RequestOpts) (*BlocklistResourcePagingResource, error) {
+	var res BlocklistResourcePagingResource
+	_, err := c.http.Get(ctx, c.cfg.Host, "/api/v3/blocklist", &res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteBlocklistItem removes a release from the blocklist
+func (c *Client) DeleteBlocklistItem(ctx context.Context, blocklistID int32) error {
+	_, err := c.http.Delete(ctx, c.cfg.Host, fmt.Sprintf("/api/v3/blocklist/%d", blocklistID), nil, nil)
+	return err
+}
+
+// DeleteBlocklistItems removes multiple releases from the blocklist at once
+func (c *Client) DeleteBlocklistItems(ctx context.Context, blocklistIDs []int32) error {
+	_, err := c.http.Delete(ctx, c.cfg.Host, "/api/v3/blocklist/bulk", nil, &BlocklistBulkResource{IDs: blocklistIDs})
+	return err
+}
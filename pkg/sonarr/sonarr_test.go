@@ -23,26 +23,30 @@ type testClient struct {
 	handler handlerFunc
 }
 
-func (c *testClient) Get(ctx context.Context, base, endpoint string, expRes any, opts ...httpclient.RequestOpts) (int, error) {
+func (c *testClient) do(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
 	if c.mock {
 		return 0, errors.New("mocked")
 	}
 	if c.handler == nil {
 		return 0, errors.New("no handler")
 	}
-	return c.handler(ctx, base, endpoint, http.MethodGet, expRes, nil, opts...)
+	return c.handler(ctx, base, endpoint, method, expRes, reqData, opts...)
+}
+
+func (c *testClient) Get(ctx context.Context, base, endpoint string, expRes any, opts ...httpclient.RequestOpts) (int, error) {
+	return c.do(ctx, base, endpoint, http.MethodGet, expRes, nil, opts...)
 }
 
 func (c *testClient) Post(ctx context.Context, base, endpoint string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
-	return 0, errors.New("not implemented")
+	return c.do(ctx, base, endpoint, http.MethodPost, expRes, reqData, opts...)
 }
 
 func (c *testClient) Put(ctx context.Context, base, endpoint string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
-	return 0, errors.New("not implemented")
+	return c.do(ctx, base, endpoint, http.MethodPut, expRes, reqData, opts...)
 }
 
 func (c *testClient) Delete(ctx context.Context, base, endpoint string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
-	return 0, errors.New("not implemented")
+	return c.do(ctx, base, endpoint, http.MethodDelete, expRes, reqData, opts...)
 }
 
 type testHandler struct {
@@ -139,63 +143,1002 @@ func TestSonarrClient(t *testing.T) {
 		serie, err = c.GetSerie(context.Background(), 78804)
 		assert.Error(t, err)
 		assert.Nil(t, serie)
+		h.mock = false
 	}
-}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/queue/1", endpoint)
+			assert.Equal(t, http.MethodDelete, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 1, len(opts))
 
-func TestTimeLeftJson(t *testing.T) {
-	tlRaw, err := time.Parse("15:04:05", "04:20:59")
-	assert.NoError(t, err)
-	tl := TimeLeft(tlRaw)
+			rExpected := &httpclient.Request{}
+			httpclient.WithParams(map[string]string{
+				"removeFromClient": "true",
+				"blocklist":        "true",
+			})(rExpected)
 
-	data, err := tl.MarshalJSON()
-	assert.NoError(t, err)
-	assert.Equal(t, `"04:20:59"`, string(data))
+			rActual := &httpclient.Request{}
+			opts[0](rActual)
 
-	var tl2 TimeLeft
-	err = tl2.UnmarshalJSON(data)
-	assert.NoError(t, err)
-	assert.Equal(t, tl, tl2)
+			assert.Equal(t, rExpected, rActual)
+			return http.StatusOK, nil
+		}
+		err := c.DeleteQueueItem(context.Background(), 1, true, true)
+		assert.NoError(t, err)
 
-	var tl3 *TimeLeft
-	data, err = json.Marshal(tl3)
-	assert.NoError(t, err)
-	assert.Equal(t, `null`, string(data))
+		h.mock = true
+		err = c.DeleteQueueItem(context.Background(), 1, true, true)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/queue/grab/1", endpoint)
+			assert.Equal(t, http.MethodPost, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 0, len(opts))
+			return http.StatusOK, nil
+		}
+		err := c.GrabQueueItem(context.Background(), 1)
+		assert.NoError(t, err)
 
-	var tl4 *TimeLeft
-	err = tl4.UnmarshalJSON(data)
-	assert.NoError(t, err)
-	assert.Nil(t, tl4)
+		h.mock = true
+		err = c.GrabQueueItem(context.Background(), 1)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		start := time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 0, 7)
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/calendar", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 1, len(opts))
 
-	var tl5 *TimeLeft
-	err = tl5.UnmarshalJSON([]byte(`"invalid"`))
-	assert.Error(t, err)
-}
+			rExpected := &httpclient.Request{}
+			httpclient.WithParams(map[string]string{
+				"start":         "2023-05-08T00:00:00Z",
+				"end":           "2023-05-15T00:00:00Z",
+				"unmonitored":   "false",
+				"includeSeries": "true",
+			})(rExpected)
 
-func TestCivilTimeJson(t *testing.T) {
-	ctRaw, err := time.Parse("2006-01-02", "2023-04-20")
-	assert.NoError(t, err)
-	ct := CivilTime(ctRaw)
+			rActual := &httpclient.Request{}
+			opts[0](rActual)
 
-	data, err := ct.MarshalJSON()
-	assert.NoError(t, err)
-	assert.Equal(t, `"2023-04-20"`, string(data))
+			assert.Equal(t, rExpected, rActual)
 
-	var ct2 CivilTime
-	err = ct2.UnmarshalJSON(data)
-	assert.NoError(t, err)
-	assert.Equal(t, ct, ct2)
+			err := json.Unmarshal(mustFile("testdata/calendar.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		episodes, err := c.GetCalendar(context.Background(), start, end, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(episodes))
+		assert.Equal(t, "Succession", episodes[0].Series.Title)
+		assert.Equal(t, time.Date(2023, 5, 11, 1, 0, 0, 0, time.UTC), episodes[0].AirDateUTC)
+		assert.True(t, episodes[1].HasFile)
 
-	var ct3 *CivilTime
-	data, err = json.Marshal(ct3)
-	assert.NoError(t, err)
-	assert.Equal(t, `null`, string(data))
+		h.mock = true
+		episodes, err = c.GetCalendar(context.Background(), start, end, false)
+		assert.Error(t, err)
+		assert.Nil(t, episodes)
+		h.mock = false
+	}
+	for endpoint, get := range map[string]func(context.Context, ...httpclient.RequestOpts) (*EpisodeResourcePagingResource, error){
+		"/api/v3/wanted/missing": c.GetMissings,
+		"/api/v3/wanted/cutoff":  c.GetCutoffUnmet,
+	} {
+		h.handler = func(ctx context.Context, base, endpoint2, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, endpoint, endpoint2)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 2, len(opts))
 
-	var ct4 *CivilTime
-	err = ct4.UnmarshalJSON(data)
-	assert.NoError(t, err)
-	assert.Nil(t, ct4)
+			rExpected := &httpclient.Request{}
+			httpclient.WithPage(2)(rExpected)
+			httpclient.WithPageSize(20)(rExpected)
 
-	var ct5 *CivilTime
-	err = ct5.UnmarshalJSON([]byte(`"invalid"`))
-	assert.Error(t, err)
+			rActual := &httpclient.Request{}
+			for _, opt := range opts {
+				opt(rActual)
+			}
+
+			assert.Equal(t, rExpected, rActual)
+
+			err := json.Unmarshal([]byte(`{"page":2,"pageSize":20,"totalRecords":21,"records":[{"id":1}]}`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		res, err := get(context.Background(), httpclient.WithPage(2), httpclient.WithPageSize(20))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(21), res.TotalRecords)
+		assert.Equal(t, 1, len(res.Records))
+
+		h.mock = true
+		res, err = get(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/release", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 1, len(opts))
+
+			err := json.Unmarshal(mustFile("testdata/releases.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		releases, err := c.GetReleases(context.Background(), httpclient.WithParams(map[string]string{"episodeId": "1"}))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(releases))
+		assert.Equal(t, int32(42), *releases[0].Seeders)
+		assert.Nil(t, releases[1].Seeders)
+		assert.Equal(t, []string{"Not an upgrade for existing episode file(s)"}, releases[1].Rejections)
+
+		h.mock = true
+		releases, err = c.GetReleases(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, releases)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/command/12", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 0, len(opts))
+
+			err := json.Unmarshal(mustFile("testdata/command.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		command, err := c.GetCommand(context.Background(), 12)
+		assert.NoError(t, err)
+		assert.Equal(t, "SeriesSearch", command.Name)
+		assert.Equal(t, CommandStatusCompleted, command.Status)
+		assert.Equal(t, 2*time.Second+500*time.Millisecond, command.Duration.Duration())
+
+		h.mock = true
+		command, err = c.GetCommand(context.Background(), 12)
+		assert.Error(t, err)
+		assert.Nil(t, command)
+		h.mock = false
+	}
+	{
+		since := time.Date(2023, 5, 24, 0, 0, 0, 0, time.UTC)
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/history/since", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 1, len(opts))
+
+			rExpected := &httpclient.Request{}
+			httpclient.WithParams(map[string]string{
+				"date":           "2023-05-24T00:00:00Z",
+				"eventType":      "1",
+				"includeSeries":  "true",
+				"includeEpisode": "true",
+			})(rExpected)
+			rActual := &httpclient.Request{}
+			opts[0](rActual)
+			assert.Equal(t, rExpected, rActual)
+
+			err := json.Unmarshal(mustFile("testdata/history.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		history, err := c.GetHistorySince(context.Background(), since, EpisodeHistoryEventTypeGrabbed)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(history))
+		assert.Equal(t, EpisodeHistoryEventTypeGrabbed, history[0].EventType)
+		assert.Equal(t, "Some Indexer", history[0].Data["indexer"])
+		assert.Equal(t, "", history[0].Data["publishedDate"])
+		assert.Equal(t, EpisodeHistoryEventTypeDownloadFolderImported, history[1].EventType)
+
+		h.mock = true
+		history, err = c.GetHistorySince(context.Background(), since, "")
+		assert.Error(t, err)
+		assert.Nil(t, history)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/history/series", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 1, len(opts))
+
+			rExpected := &httpclient.Request{}
+			httpclient.WithParams(map[string]string{
+				"seriesId":       "1",
+				"includeSeries":  "true",
+				"includeEpisode": "true",
+			})(rExpected)
+			rActual := &httpclient.Request{}
+			opts[0](rActual)
+			assert.Equal(t, rExpected, rActual)
+
+			err := json.Unmarshal(mustFile("testdata/history.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		history, err := c.GetSeriesHistory(context.Background(), 1, "")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(history))
+
+		h.mock = true
+		history, err = c.GetSeriesHistory(context.Background(), 1, "")
+		assert.Error(t, err)
+		assert.Nil(t, history)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/history/failed/21", endpoint)
+			assert.Equal(t, http.MethodPost, method)
+			assert.Nil(t, expRes)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 0, len(opts))
+			return http.StatusOK, nil
+		}
+		err := c.MarkHistoryFailed(context.Background(), 21)
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.MarkHistoryFailed(context.Background(), 21)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/tag", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 0, len(opts))
+
+			err := json.Unmarshal([]byte(`[{"id":1,"label":"anime"},{"id":2,"label":"4k"}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		tags, err := c.GetTags(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*TagResource{{ID: 1, Label: "anime"}, {ID: 2, Label: "4k"}}, tags)
+
+		h.mock = true
+		tags, err = c.GetTags(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, tags)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/queue/details", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 1, len(opts))
+
+			err := json.Unmarshal([]byte(`[{"id":1,"seriesId":3},{"id":2,"seriesId":78}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		queue, err := c.GetQueueDetails(context.Background(), 0)
+		assert.NoError(t, err)
+		assert.Len(t, queue, 2)
+
+		h.mock = true
+		queue, err = c.GetQueueDetails(context.Background(), 0)
+		assert.Error(t, err)
+		assert.Nil(t, queue)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/tag/2", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`{"id":2,"label":"4k"}`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		tag, err := c.GetTag(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, &TagResource{ID: 2, Label: "4k"}, tag)
+
+		h.mock = true
+		tag, err = c.GetTag(context.Background(), 2)
+		assert.Error(t, err)
+		assert.Nil(t, tag)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/tag", endpoint)
+			assert.Equal(t, http.MethodPost, method)
+			assert.Equal(t, &TagResource{Label: "usenet"}, reqData)
+
+			err := json.Unmarshal([]byte(`{"id":3,"label":"usenet"}`), expRes)
+			assert.NoError(t, err)
+			return http.StatusCreated, nil
+		}
+		tag, err := c.PostTag(context.Background(), "usenet")
+		assert.NoError(t, err)
+		assert.Equal(t, &TagResource{ID: 3, Label: "usenet"}, tag)
+
+		h.mock = true
+		tag, err = c.PostTag(context.Background(), "usenet")
+		assert.Error(t, err)
+		assert.Nil(t, tag)
+		h.mock = false
+	}
+	{
+		tag := &TagResource{ID: 3, Label: "torrent"}
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/tag/3", endpoint)
+			assert.Equal(t, http.MethodPut, method)
+			assert.Equal(t, tag, reqData)
+
+			err := json.Unmarshal([]byte(`{"id":3,"label":"torrent"}`), expRes)
+			assert.NoError(t, err)
+			return http.StatusAccepted, nil
+		}
+		res, err := c.PutTag(context.Background(), tag)
+		assert.NoError(t, err)
+		assert.Equal(t, tag, res)
+
+		h.mock = true
+		res, err = c.PutTag(context.Background(), tag)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/tag/3", endpoint)
+			assert.Equal(t, http.MethodDelete, method)
+			assert.Nil(t, expRes)
+			assert.Nil(t, reqData)
+			return http.StatusOK, nil
+		}
+		err := c.DeleteTag(context.Background(), 3)
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.DeleteTag(context.Background(), 3)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/tag/detail", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`[{"id":1,"label":"anime","indexerIds":[4],"seriesIds":[1,2]},{"id":2,"label":"4k"}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		details, err := c.GetTagDetails(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, details, 2)
+		assert.Equal(t, []int32{1, 2}, details[0].SeriesIDs)
+		assert.True(t, details[0].InUse())
+		assert.False(t, details[1].InUse())
+
+		h.mock = true
+		details, err = c.GetTagDetails(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, details)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/tag/detail/1", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`{"id":1,"label":"anime","downloadClientIds":[2]}`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		detail, err := c.GetTagDetail(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []int32{2}, detail.DownloadClientIDs)
+
+		h.mock = true
+		detail, err = c.GetTagDetail(context.Background(), 1)
+		assert.Error(t, err)
+		assert.Nil(t, detail)
+		h.mock = false
+	}
+	{
+		monitored := false
+		editor := &SeriesEditorResource{SeriesIDs: []int32{1, 2}, Monitored: &monitored, Tags: []int32{3}, ApplyTags: ApplyTagsAdd}
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/series/editor", endpoint)
+			assert.Equal(t, http.MethodPut, method)
+			assert.Equal(t, editor, reqData)
+			assert.Equal(t, 0, len(opts))
+
+			err := json.Unmarshal(mustFile("testdata/series.json"), expRes)
+			assert.NoError(t, err)
+			return http.StatusAccepted, nil
+		}
+		series, err := c.PutSeriesEditor(context.Background(), editor)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, series)
+
+		h.mock = true
+		series, err = c.PutSeriesEditor(context.Background(), editor)
+		assert.Error(t, err)
+		assert.Nil(t, series)
+		h.mock = false
+	}
+	{
+		editor := &SeriesEditorResource{SeriesIDs: []int32{1, 2}, DeleteFiles: true}
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/series/editor", endpoint)
+			assert.Equal(t, http.MethodDelete, method)
+			assert.Nil(t, expRes)
+			assert.Equal(t, editor, reqData)
+			return http.StatusOK, nil
+		}
+		err := c.DeleteSeriesEditor(context.Background(), editor)
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.DeleteSeriesEditor(context.Background(), editor)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/rename", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 1, len(opts))
+
+			err := json.Unmarshal([]byte(`[{"seriesId":1,"seasonNumber":2,"episodeNumbers":[3,4],"episodeFileId":5,"existingPath":"Season 2/show.s02e03.mkv","newPath":"Season 02/Show - S02E03-E04.mkv"}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		files, err := c.GetRenamePreview(context.Background(), httpclient.WithParams(map[string]string{"seriesId": "1"}))
+		assert.NoError(t, err)
+		assert.Equal(t, []*RenameEpisodeResource{{
+			SeriesID:       1,
+			SeasonNumber:   2,
+			EpisodeNumbers: []int32{3, 4},
+			EpisodeFileID:  5,
+			ExistingPath:   "Season 2/show.s02e03.mkv",
+			NewPath:        "Season 02/Show - S02E03-E04.mkv",
+		}}, files)
+
+		h.mock = true
+		files, err = c.GetRenamePreview(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, files)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/manualimport", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Equal(t, 1, len(opts))
+
+			err := json.Unmarshal([]byte(`[{"id":1,"path":"/downloads/show/show.s01e02.mkv","seasonNumber":1,"episodes":[{"id":3}],"rejections":[{"reason":"Not a sample","type":"permanent"}]}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		files, err := c.GetManualImport(context.Background(), httpclient.WithParams(map[string]string{"downloadId": "abc"}))
+		assert.NoError(t, err)
+		season := int32(1)
+		assert.Equal(t, []*ManualImportResource{{
+			ID:           1,
+			Path:         "/downloads/show/show.s01e02.mkv",
+			SeasonNumber: &season,
+			Episodes:     []*EpisodeResource{{ID: 3}},
+			Rejections:   []*ImportRejectionResource{{Reason: "Not a sample", Type: PermanentRejection}},
+		}}, files)
+
+		h.mock = true
+		files, err = c.GetManualImport(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, files)
+		h.mock = false
+	}
+	{
+		req := &ManualImportCommandRequest{
+			Name:       "ManualImport",
+			Files:      []*ManualImportFile{{Path: "/downloads/show.mkv", SeriesID: 1, EpisodeIDs: []int32{2}}},
+			ImportMode: ImportModeCopy,
+		}
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/command", endpoint)
+			assert.Equal(t, http.MethodPost, method)
+			assert.Equal(t, req, reqData)
+			assert.Equal(t, 0, len(opts))
+			return http.StatusOK, nil
+		}
+		_, err := c.PostManualImportCommand(context.Background(), req)
+		assert.NoError(t, err)
+
+		h.mock = true
+		res, err := c.PostManualImportCommand(context.Background(), req)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/episodefile/bulk", endpoint)
+			assert.Equal(t, http.MethodDelete, method)
+			assert.Nil(t, expRes)
+			assert.Equal(t, &EpisodeFileListResource{EpisodeFileIDs: []int32{1, 2}}, reqData)
+			return http.StatusOK, nil
+		}
+		err := c.DeleteEpisodeFiles(context.Background(), []int32{1, 2})
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.DeleteEpisodeFiles(context.Background(), []int32{1, 2})
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		editor := &EpisodeFileListResource{
+			EpisodeFileIDs: []int32{1},
+			Languages:      []Language{{ID: 1, Name: "English"}},
+		}
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/episodefile/editor", endpoint)
+			assert.Equal(t, http.MethodPut, method)
+			assert.Nil(t, expRes)
+			assert.Equal(t, editor, reqData)
+
+			// fields which aren't set must not be sent, otherwise sonarr would reset them
+			b, err := json.Marshal(reqData)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"episodeFileIds":[1],"languages":[{"id":1,"name":"English"}]}`, string(b))
+			return http.StatusOK, nil
+		}
+		err := c.PutEpisodeFileEditor(context.Background(), editor)
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.PutEpisodeFileEditor(context.Background(), editor)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/qualitydefinition", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`[{"id":1,"quality":{"id":3,"name":"WEBDL-1080p","source":"web","resolution":1080},"title":"WEBDL-1080p","weight":10}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		definitions, err := c.GetQualityDefinitions(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*QualityDefinitionResource{{
+			ID:      1,
+			Quality: &Quality{ID: 3, Name: "WEBDL-1080p", Source: Web, Resolution: 1080},
+			Title:   "WEBDL-1080p",
+			Weight:  10,
+		}}, definitions)
+
+		h.mock = true
+		definitions, err = c.GetQualityDefinitions(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, definitions)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/language", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`[{"id":1,"name":"English","nameLower":"english"}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		languages, err := c.GetLanguages(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*Language{{ID: 1, Name: "English"}}, languages)
+
+		h.mock = true
+		languages, err = c.GetLanguages(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, languages)
+		h.mock = false
+	}
+	{
+		params := &EpisodesMonitoredResource{EpisodeIDs: []int32{5, 6, 7}, Monitored: true}
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/episode/monitor", endpoint)
+			assert.Equal(t, http.MethodPut, method)
+			assert.Nil(t, expRes)
+			assert.Equal(t, params, reqData)
+			return http.StatusAccepted, nil
+		}
+		err := c.SetEpisodesMonitored(context.Background(), params)
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.SetEpisodesMonitored(context.Background(), params)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		params := &SeasonPassResource{
+			Series:            []*SeasonPassSeriesResource{{ID: 1}, {ID: 2}},
+			MonitoringOptions: &MonitoringOptions{Monitor: LatestSeason},
+		}
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/seasonpass", endpoint)
+			assert.Equal(t, http.MethodPost, method)
+			assert.Nil(t, expRes)
+			assert.Equal(t, params, reqData)
+
+			// the monitored state and the seasons of the series must not be sent, otherwise sonarr would reset them
+			b, err := json.Marshal(reqData)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"series":[{"id":1},{"id":2}],"monitoringOptions":{"ignoreEpisodesWithFiles":false,"ignoreEpisodesWithoutFiles":false,"monitor":"latestSeason"}}`, string(b))
+			return http.StatusAccepted, nil
+		}
+		err := c.PostSeasonPass(context.Background(), params)
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.PostSeasonPass(context.Background(), params)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/system/status", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`{"appName":"Sonarr","version":"4.0.0.1","appData":"/config","osName":"ubuntu","osVersion":"22.04","isDocker":true,"runtimeName":".NET","runtimeVersion":"6.0.13","startTime":"2023-06-01T12:00:00Z"}`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		status, err := c.GetSystemStatus(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, &SystemResource{
+			AppName:        "Sonarr",
+			Version:        "4.0.0.1",
+			AppData:        "/config",
+			OsName:         "ubuntu",
+			OsVersion:      "22.04",
+			IsDocker:       true,
+			RuntimeName:    ".NET",
+			RuntimeVersion: "6.0.13",
+			StartTime:      time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+		}, status)
+
+		h.mock = true
+		status, err = c.GetSystemStatus(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, status)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/health", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`[{"source":"IndexerStatusCheck","type":"warning","message":"Indexers unavailable due to failures","wikiUrl":"https://wiki.servarr.com/#indexers-are-unavailable-due-to-failures"}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		health, err := c.GetHealth(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*HealthResource{{
+			Source:  "IndexerStatusCheck",
+			Type:    HealthCheckWarning,
+			Message: "Indexers unavailable due to failures",
+			WikiURL: "https://wiki.servarr.com/#indexers-are-unavailable-due-to-failures",
+		}}, health)
+
+		h.mock = true
+		health, err = c.GetHealth(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, health)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/diskspace", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`[{"path":"/data","label":"data","freeSpace":1024,"totalSpace":4096}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		disks, err := c.GetDiskSpace(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*DiskSpaceResource{{Path: "/data", Label: "data", FreeSpace: 1024, TotalSpace: 4096}}, disks)
+
+		h.mock = true
+		disks, err = c.GetDiskSpace(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, disks)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/system/task", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+
+			err := json.Unmarshal([]byte(`[{"id":1,"name":"Backup","taskName":"Backup","interval":10080,"lastExecution":"2023-06-01T12:00:00Z","nextExecution":"2023-06-08T12:00:00Z"}]`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		tasks, err := c.GetTasks(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*TaskResource{{
+			ID:            1,
+			Name:          "Backup",
+			TaskName:      "Backup",
+			Interval:      10080,
+			LastExecution: time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+			NextExecution: time.Date(2023, 6, 8, 12, 0, 0, 0, time.UTC),
+		}}, tasks)
+
+		h.mock = true
+		tasks, err = c.GetTasks(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, tasks)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/blocklist", endpoint)
+			assert.Equal(t, http.MethodGet, method)
+			assert.Nil(t, reqData)
+			assert.Len(t, opts, 2)
+
+			err := json.Unmarshal([]byte(`{"page":2,"pageSize":20,"totalRecords":21,"records":[{"id":3,"seriesId":1,"episodeIds":[4,5],"sourceTitle":"Series.S01E01-E02.1080p","date":"2023-06-01T12:00:00Z","protocol":"torrent","indexer":"nyaa","message":"Manually marked as failed"}]}`), expRes)
+			assert.NoError(t, err)
+			return http.StatusOK, nil
+		}
+		blocklist, err := c.GetBlocklist(context.Background(), httpclient.WithPage(2), httpclient.WithPageSize(20))
+		assert.NoError(t, err)
+		assert.Equal(t, &BlocklistResourcePagingResource{
+			Page:         2,
+			PageSize:     20,
+			TotalRecords: 21,
+			Records: []*BlocklistResource{{
+				ID:          3,
+				SeriesID:    1,
+				EpisodeIDs:  []int32{4, 5},
+				SourceTitle: "Series.S01E01-E02.1080p",
+				Date:        time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+				Protocol:    Torrent,
+				Indexer:     "nyaa",
+				Message:     "Manually marked as failed",
+			}},
+		}, blocklist)
+
+		h.mock = true
+		blocklist, err = c.GetBlocklist(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, blocklist)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/blocklist/3", endpoint)
+			assert.Equal(t, http.MethodDelete, method)
+			assert.Nil(t, expRes)
+			assert.Nil(t, reqData)
+			return http.StatusOK, nil
+		}
+		err := c.DeleteBlocklistItem(context.Background(), 3)
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.DeleteBlocklistItem(context.Background(), 3)
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/blocklist/bulk", endpoint)
+			assert.Equal(t, http.MethodDelete, method)
+			assert.Nil(t, expRes)
+			assert.Equal(t, &BlocklistBulkResource{IDs: []int32{3, 4}}, reqData)
+			return http.StatusOK, nil
+		}
+		err := c.DeleteBlocklistItems(context.Background(), []int32{3, 4})
+		assert.NoError(t, err)
+
+		h.mock = true
+		err = c.DeleteBlocklistItems(context.Background(), []int32{3, 4})
+		assert.Error(t, err)
+		h.mock = false
+	}
+	{
+		release := &ReleaseResource{GUID: "abc", IndexerID: 2}
+		h.handler = func(ctx context.Context, base, endpoint, method string, expRes, reqData any, opts ...httpclient.RequestOpts) (int, error) {
+			assert.Equal(t, testSonarrHost, base)
+			assert.Equal(t, "/api/v3/release", endpoint)
+			assert.Equal(t, http.MethodPost, method)
+			assert.Equal(t, release, reqData)
+			assert.Equal(t, 0, len(opts))
+			return http.StatusOK, nil
+		}
+		_, err := c.PostRelease(context.Background(), release)
+		assert.NoError(t, err)
+
+		h.mock = true
+		res, err := c.PostRelease(context.Background(), release)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		h.mock = false
+	}
+}
+
+func TestTimeLeftJson(t *testing.T) {
+	tlRaw, err := time.Parse("15:04:05", "04:20:59")
+	assert.NoError(t, err)
+	tl := TimeLeft(tlRaw)
+
+	data, err := tl.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"04:20:59"`, string(data))
+
+	var tl2 TimeLeft
+	err = tl2.UnmarshalJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, tl, tl2)
+
+	var tl3 *TimeLeft
+	data, err = json.Marshal(tl3)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	var tl4 *TimeLeft
+	err = tl4.UnmarshalJSON(data)
+	assert.NoError(t, err)
+	assert.Nil(t, tl4)
+
+	var tl5 *TimeLeft
+	err = tl5.UnmarshalJSON([]byte(`"invalid"`))
+	assert.Error(t, err)
+
+	var tl6 TimeLeft
+	err = tl6.UnmarshalJSON([]byte(`"1.02:03:04"`))
+	assert.NoError(t, err)
+	assert.Equal(t, 26*time.Hour+3*time.Minute+4*time.Second, tl6.Duration())
+
+	data, err = tl6.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"1.02:03:04"`, string(data))
+
+	var tl7 TimeLeft
+	err = tl7.UnmarshalJSON([]byte(`"00:10:00.5000000"`))
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute+500*time.Millisecond, tl7.Duration())
+
+	var tl8 TimeLeft
+	err = tl8.UnmarshalJSON([]byte(`"1.02:03:04.2500000"`))
+	assert.NoError(t, err)
+	assert.Equal(t, 26*time.Hour+3*time.Minute+4*time.Second+250*time.Millisecond, tl8.Duration())
+
+	assert.Equal(t, 4*time.Hour+20*time.Minute+59*time.Second, tl.Duration())
+	assert.Equal(t, time.Duration(0), TimeLeft{}.Duration())
+}
+
+func TestCivilTimeJson(t *testing.T) {
+	ctRaw, err := time.Parse("2006-01-02", "2023-04-20")
+	assert.NoError(t, err)
+	ct := CivilTime(ctRaw)
+
+	data, err := ct.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"2023-04-20"`, string(data))
+
+	var ct2 CivilTime
+	err = ct2.UnmarshalJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, ct, ct2)
+
+	var ct3 *CivilTime
+	data, err = json.Marshal(ct3)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	var ct4 *CivilTime
+	err = ct4.UnmarshalJSON(data)
+	assert.NoError(t, err)
+	assert.Nil(t, ct4)
+
+	var ct5 *CivilTime
+	err = ct5.UnmarshalJSON([]byte(`"invalid"`))
+	assert.Error(t, err)
+}
+
+func TestCommandDurationJson(t *testing.T) {
+	for raw, expected := range map[string]time.Duration{
+		`"00:00:01.2345670"`:    time.Second + 234567000*time.Nanosecond,
+		`"00:01:02"`:            time.Minute + 2*time.Second,
+		`"1.02:03:04"`:          26*time.Hour + 3*time.Minute + 4*time.Second,
+		`"12.00:00:00.5000000"`: 12*24*time.Hour + 500*time.Millisecond,
+	} {
+		var d CommandDuration
+		err := json.Unmarshal([]byte(raw), &d)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, d.Duration())
+	}
+
+	var d CommandDuration
+	err := json.Unmarshal([]byte(`null`), &d)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), d.Duration())
+
+	err = json.Unmarshal([]byte(`"1:2"`), &d)
+	assert.Error(t, err)
+
+	b, err := json.Marshal(CommandDuration(26*time.Hour + 3*time.Minute + 4*time.Second + 500*time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, `"1.02:03:04.5000000"`, string(b))
+
+	b, err = json.Marshal(CommandDuration(time.Second + 234567000*time.Nanosecond))
+	assert.NoError(t, err)
+	assert.Equal(t, `"00:00:01.2345670"`, string(b))
+
+	var d2 CommandDuration
+	err = json.Unmarshal(b, &d2)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second+234567000*time.Nanosecond, d2.Duration())
 }
@@ -1,6 +1,8 @@
 package sonarr
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -122,6 +124,50 @@ const (
 	Anime    SeriesType = "anime"
 )
 
+// SeriesEditorResource changes or deletes multiple series at once.
+// Fields which are nil or empty are left unchanged, an empty but non-nil slice of tags removes all tags if they are replaced.
+type SeriesEditorResource struct {
+	SeriesIDs              []int32    `json:"seriesIds"`
+	Monitored              *bool      `json:"monitored,omitempty"`
+	QualityProfileID       *int32     `json:"qualityProfileId,omitempty"`
+	SeriesType             SeriesType `json:"seriesType,omitempty"`
+	SeasonFolder           *bool      `json:"seasonFolder,omitempty"`
+	RootFolderPath         string     `json:"rootFolderPath,omitempty"`
+	Tags                   []int32    `json:"tags"`
+	ApplyTags              ApplyTags  `json:"applyTags,omitempty"`
+	MoveFiles              bool       `json:"moveFiles,omitempty"`
+	DeleteFiles            bool       `json:"deleteFiles,omitempty"`
+	AddImportListExclusion bool       `json:"addImportListExclusion,omitempty"`
+}
+
+// SeasonPassResource changes the monitoring of multiple series at once
+type SeasonPassResource struct {
+	Series            []*SeasonPassSeriesResource `json:"series"`
+	MonitoringOptions *MonitoringOptions          `json:"monitoringOptions,omitempty"`
+}
+
+// SeasonPassSeriesResource selects a series of the season pass.
+// Monitored and the seasons are only changed if they are set.
+type SeasonPassSeriesResource struct {
+	ID        int32             `json:"id"`
+	Monitored *bool             `json:"monitored,omitempty"`
+	Seasons   []*SeasonResource `json:"seasons,omitempty"`
+}
+
+type MonitoringOptions struct {
+	IgnoreEpisodesWithFiles    bool        `json:"ignoreEpisodesWithFiles"`
+	IgnoreEpisodesWithoutFiles bool        `json:"ignoreEpisodesWithoutFiles"`
+	Monitor                    MonitorType `json:"monitor"`
+}
+
+type ApplyTags string
+
+const (
+	ApplyTagsAdd     ApplyTags = "add"
+	ApplyTagsRemove  ApplyTags = "remove"
+	ApplyTagsReplace ApplyTags = "replace"
+)
+
 type AddSeriesOptions struct {
 	IgnoreEpisodesWithFiles      bool        `json:"ignoreEpisodesWithFiles"`
 	IgnoreEpisodesWithoutFiles   bool        `json:"ignoreEpisodesWithoutFiles"`
@@ -140,6 +186,7 @@ const (
 	Existing           MonitorType = "existing"
 	FirstSeason        MonitorType = "firstSeason"
 	LastSeason         MonitorType = "lastSeason"
+	LatestSeason       MonitorType = "latestSeason"
 	Pilot              MonitorType = "pilot"
 	None               MonitorType = "none"
 )
@@ -200,25 +247,51 @@ type QueueResource struct {
 	OutputPath              string                         `json:"outputPath"`
 }
 
-// TimeLeft is a custom type to handle the timeleft field
+// TimeLeft is a custom type to handle the timeleft field.
+// Sonarr prefixes the time with the number of days if the download takes longer than a day (e.g. 1.02:03:04).
 type TimeLeft time.Time
 
+// timeLeftZero is the reference time from which the duration of a TimeLeft is calculated
+var timeLeftZero, _ = time.Parse("15:04:05", "00:00:00")
+
 func (tl *TimeLeft) UnmarshalJSON(b []byte) (err error) {
 	value := strings.Trim(string(b), `"`) // get rid of "
 	if value == "" || value == "null" {
 		return nil
 	}
 
+	// the days are separated by a dot before the hours, a dot after the seconds separates the fraction
+	var days int
+	if i := strings.Index(value, "."); i >= 0 && i < strings.Index(value, ":") {
+		days, err = strconv.Atoi(value[:i])
+		if err != nil {
+			return err
+		}
+		value = value[i+1:]
+	}
+
 	t, err := time.Parse("15:04:05", value) // parse time
 	if err != nil {
 		return err
 	}
-	*tl = TimeLeft(t) // set result using the pointer
+	*tl = TimeLeft(t.AddDate(0, 0, days)) // set result using the pointer
 	return nil
 }
 
 func (tl TimeLeft) MarshalJSON() ([]byte, error) {
-	return []byte(`"` + time.Time(tl).Format("15:04:05") + `"`), nil
+	t := time.Time(tl)
+	if days := int(tl.Duration().Hours()) / 24; days > 0 {
+		return []byte(fmt.Sprintf(`"%d.%s"`, days, t.Format("15:04:05"))), nil
+	}
+	return []byte(`"` + t.Format("15:04:05") + `"`), nil
+}
+
+// Duration returns the time left as duration
+func (tl TimeLeft) Duration() time.Duration {
+	if time.Time(tl).IsZero() {
+		return 0
+	}
+	return time.Time(tl).Sub(timeLeftZero)
 }
 
 type EpisodeResource struct {
@@ -286,6 +359,26 @@ type EpisodeFileResource struct {
 	QualityCutoffNotMet bool                   `json:"qualityCutoffNotMet"`
 }
 
+// EpisodeFileListResource selects episode files for bulk operations.
+// Only the set fields are changed by the editor.
+type EpisodeFileListResource struct {
+	EpisodeFileIDs []int32       `json:"episodeFileIds"`
+	Languages      []Language    `json:"languages,omitempty"`
+	Quality        *QualityModel `json:"quality,omitempty"`
+	SceneName      string        `json:"sceneName,omitempty"`
+	ReleaseGroup   string        `json:"releaseGroup,omitempty"`
+}
+
+type QualityDefinitionResource struct {
+	ID            int32    `json:"id"`
+	Quality       *Quality `json:"quality"`
+	Title         string   `json:"title"`
+	Weight        int32    `json:"weight"`
+	MinSize       float64  `json:"minSize"`
+	MaxSize       float64  `json:"maxSize"`
+	PreferredSize float64  `json:"preferredSize"`
+}
+
 type QualityModel struct {
 	Quality  *Quality  `json:"quality"`
 	Revision *Revision `json:"revision"`
@@ -478,7 +571,7 @@ type CommandResource struct {
 	Queued              time.Time       `json:"queued"`
 	Started             time.Time       `json:"started"`
 	Ended               time.Time       `json:"ended"`
-	Duration            time.Duration   `json:"duration"`
+	Duration            CommandDuration `json:"duration"`
 	Exception           string          `json:"exception"`
 	Trigger             CommandTrigger  `json:"trigger"`
 	ClientUserAgent     string          `json:"clientUserAgent"`
@@ -488,6 +581,71 @@ type CommandResource struct {
 	LastExecutionTime   time.Time       `json:"lastExecutionTime"`
 }
 
+// CommandDuration is a custom type to handle the duration field of commands.
+// Sonarr formats the duration as a .NET TimeSpan (e.g. 00:00:01.2345678 or 1.02:03:04).
+type CommandDuration time.Duration
+
+func (d *CommandDuration) UnmarshalJSON(b []byte) (err error) {
+	value := strings.Trim(string(b), `"`) // get rid of "
+	if value == "" || value == "null" {
+		return nil
+	}
+
+	// the days are separated by a dot before the hours
+	var days int
+	if i := strings.Index(value, "."); i >= 0 && i < strings.Index(value, ":") {
+		days, err = strconv.Atoi(value[:i])
+		if err != nil {
+			return err
+		}
+		value = value[i+1:]
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return fmt.Errorf("invalid duration %q", value)
+	}
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return err
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return err
+	}
+	seconds, err := time.ParseDuration(parts[2] + "s")
+	if err != nil {
+		return err
+	}
+
+	*d = CommandDuration(time.Duration(days)*24*time.Hour +
+		time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		seconds) // set result using the pointer
+	return nil
+}
+
+func (d CommandDuration) MarshalJSON() ([]byte, error) {
+	duration := time.Duration(d)
+	days := duration / (24 * time.Hour)
+	duration -= days * 24 * time.Hour
+	hours := duration / time.Hour
+	duration -= hours * time.Hour
+	minutes := duration / time.Minute
+	duration -= minutes * time.Minute
+	seconds := float64(duration) / float64(time.Second)
+
+	if days > 0 {
+		return []byte(fmt.Sprintf(`"%d.%02d:%02d:%010.7f"`, days, hours, minutes, seconds)), nil
+	}
+	return []byte(fmt.Sprintf(`"%02d:%02d:%010.7f"`, hours, minutes, seconds)), nil
+}
+
+// Duration returns the command duration as time.Duration
+func (d CommandDuration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
 type Command struct {
 	SendUpdatesToClient bool           `json:"sendUpdatesToClient"`
 	LastExecutionTime   time.Time      `json:"lastExecutionTime"`
@@ -539,7 +697,81 @@ type CommandRequest struct {
 	Name         string  `json:"name"`
 	SeasonNumber int32   `json:"seasonNumber,omitempty"`
 	SeriesID     int32   `json:"seriesId,omitempty"`
+	SeriesIDs    []int32 `json:"seriesIds,omitempty"`
 	EpisodeIDs   []int32 `json:"episodeIds,omitempty"`
+	Files        []int32 `json:"files,omitempty"`
+}
+
+// RenameEpisodeResource is a file which would be renamed by the current naming config
+type RenameEpisodeResource struct {
+	SeriesID       int32   `json:"seriesId"`
+	SeasonNumber   int32   `json:"seasonNumber"`
+	EpisodeNumbers []int32 `json:"episodeNumbers"`
+	EpisodeFileID  int32   `json:"episodeFileId"`
+	ExistingPath   string  `json:"existingPath"`
+	NewPath        string  `json:"newPath"`
+}
+
+// ManualImportResource is a file which can be imported manually
+type ManualImportResource struct {
+	ID                int32                      `json:"id"`
+	Path              string                     `json:"path"`
+	RelativePath      string                     `json:"relativePath"`
+	FolderName        string                     `json:"folderName"`
+	Name              string                     `json:"name"`
+	Size              int64                      `json:"size"`
+	Series            *SeriesResource            `json:"series"`
+	SeasonNumber      *int32                     `json:"seasonNumber"`
+	Episodes          []*EpisodeResource         `json:"episodes"`
+	EpisodeFileID     int32                      `json:"episodeFileId"`
+	ReleaseGroup      string                     `json:"releaseGroup"`
+	Quality           *QualityModel              `json:"quality"`
+	Languages         []Language                 `json:"languages"`
+	QualityWeight     int32                      `json:"qualityWeight"`
+	DownloadID        string                     `json:"downloadId"`
+	CustomFormats     []CustomFormatResource     `json:"customFormats"`
+	CustomFormatScore int32                      `json:"customFormatScore"`
+	Rejections        []*ImportRejectionResource `json:"rejections"`
+}
+
+type ImportRejectionResource struct {
+	Reason string        `json:"reason"`
+	Type   RejectionType `json:"type"`
+}
+
+type RejectionType string
+
+const (
+	PermanentRejection RejectionType = "permanent"
+	TemporaryRejection RejectionType = "temporary"
+)
+
+type ImportMode string
+
+const (
+	ImportModeAuto ImportMode = "auto"
+	ImportModeMove ImportMode = "move"
+	ImportModeCopy ImportMode = "copy"
+)
+
+// ManualImportCommandRequest is the body of the ManualImport command.
+// It can't be sent as a CommandRequest because the files are objects instead of ids.
+type ManualImportCommandRequest struct {
+	Name       string              `json:"name"`
+	Files      []*ManualImportFile `json:"files"`
+	ImportMode ImportMode          `json:"importMode"`
+}
+
+// ManualImportFile is a file with the episodes it should be imported as
+type ManualImportFile struct {
+	Path         string        `json:"path"`
+	FolderName   string        `json:"folderName,omitempty"`
+	SeriesID     int32         `json:"seriesId"`
+	EpisodeIDs   []int32       `json:"episodeIds"`
+	Quality      *QualityModel `json:"quality,omitempty"`
+	Languages    []Language    `json:"languages,omitempty"`
+	ReleaseGroup string        `json:"releaseGroup,omitempty"`
+	DownloadID   string        `json:"downloadId,omitempty"`
 }
 
 type EpisodeResourcePagingResource struct {
@@ -575,7 +807,7 @@ type HistoryResource struct {
 	Date                time.Time               `json:"date"`
 	DownloadID          string                  `json:"downloadId"`
 	EventType           EpisodeHistoryEventType `json:"eventType"`
-	Data                any                     `json:"data"`
+	Data                map[string]string       `json:"data"`
 	Episode             *EpisodeResource        `json:"episode"`
 	Series              *SeriesResource         `json:"series"`
 }
@@ -593,6 +825,23 @@ const (
 	EpisodeHistoryEventTypeDownloadIgnored        EpisodeHistoryEventType = "downloadIgnored"
 )
 
+// episodeHistoryEventTypeIDs holds the numeric values of the event types, which are used to filter the history
+var episodeHistoryEventTypeIDs = map[EpisodeHistoryEventType]int{
+	EpisodeHistoryEventTypeUnknown:                0,
+	EpisodeHistoryEventTypeGrabbed:                1,
+	EpisodeHistoryEventTypeSeriesFolderImported:   2,
+	EpisodeHistoryEventTypeDownloadFolderImported: 3,
+	EpisodeHistoryEventTypeDownloadFailed:         4,
+	EpisodeHistoryEventTypeEpisodeFileDeleted:     5,
+	EpisodeHistoryEventTypeEpisodeFileRenamed:     6,
+	EpisodeHistoryEventTypeDownloadIgnored:        7,
+}
+
+// ID returns the numeric value of the event type
+func (e EpisodeHistoryEventType) ID() int {
+	return episodeHistoryEventTypeIDs[e]
+}
+
 type RootFolderResource struct {
 	ID              int32            `json:"id"`
 	Path            string           `json:"path"`
@@ -620,3 +869,168 @@ type LanguageProfileItemResource struct {
 	Language Language `json:"language"`
 	Allowed  bool     `json:"allowed"`
 }
+
+type ReleaseResource struct {
+	ID                   int32                  `json:"id"`
+	GUID                 string                 `json:"guid"`
+	Quality              *QualityModel          `json:"quality"`
+	QualityWeight        int32                  `json:"qualityWeight"`
+	Age                  int32                  `json:"age"`
+	AgeHours             float64                `json:"ageHours"`
+	AgeMinutes           float64                `json:"ageMinutes"`
+	Size                 int64                  `json:"size"`
+	IndexerID            int32                  `json:"indexerId"`
+	Indexer              string                 `json:"indexer"`
+	ReleaseGroup         string                 `json:"releaseGroup"`
+	SubGroup             string                 `json:"subGroup"`
+	ReleaseHash          string                 `json:"releaseHash"`
+	Title                string                 `json:"title"`
+	FullSeason           bool                   `json:"fullSeason"`
+	SceneSource          bool                   `json:"sceneSource"`
+	SeasonNumber         int32                  `json:"seasonNumber"`
+	Languages            []Language             `json:"languages"`
+	LanguageWeight       int32                  `json:"languageWeight"`
+	AirDate              string                 `json:"airDate"`
+	SeriesTitle          string                 `json:"seriesTitle"`
+	EpisodeNumbers       []int32                `json:"episodeNumbers"`
+	MappedSeasonNumber   int32                  `json:"mappedSeasonNumber"`
+	MappedEpisodeNumbers []int32                `json:"mappedEpisodeNumbers"`
+	MappedSeriesID       int32                  `json:"mappedSeriesId"`
+	Approved             bool                   `json:"approved"`
+	TemporarilyRejected  bool                   `json:"temporarilyRejected"`
+	Rejected             bool                   `json:"rejected"`
+	TVDBID               int32                  `json:"tvdbId"`
+	Rejections           []string               `json:"rejections"`
+	PublishDate          time.Time              `json:"publishDate"`
+	CommentURL           string                 `json:"commentUrl"`
+	DownloadURL          string                 `json:"downloadUrl"`
+	InfoURL              string                 `json:"infoUrl"`
+	EpisodeRequested     bool                   `json:"episodeRequested"`
+	DownloadAllowed      bool                   `json:"downloadAllowed"`
+	ReleaseWeight        int32                  `json:"releaseWeight"`
+	CustomFormats        []CustomFormatResource `json:"customFormats"`
+	CustomFormatScore    int32                  `json:"customFormatScore"`
+	MagnetURL            string                 `json:"magnetUrl"`
+	InfoHash             string                 `json:"infoHash"`
+	Seeders              *int32                 `json:"seeders"`
+	Leechers             *int32                 `json:"leechers"`
+	Protocol             DownloadProtocol       `json:"protocol"`
+	IsDaily              bool                   `json:"isDaily"`
+	Special              bool                   `json:"special"`
+	SeriesID             int32                  `json:"seriesId,omitempty"`
+	EpisodeID            int32                  `json:"episodeId,omitempty"`
+	EpisodeIDs           []int32                `json:"episodeIds,omitempty"`
+	DownloadClientID     int32                  `json:"downloadClientId,omitempty"`
+	ShouldOverride       bool                   `json:"shouldOverride,omitempty"`
+}
+
+type TagResource struct {
+	ID    int32  `json:"id"`
+	Label string `json:"label"`
+}
+
+type TagDetailsResource struct {
+	ID                int32   `json:"id"`
+	Label             string  `json:"label"`
+	DelayProfileIDs   []int32 `json:"delayProfileIds"`
+	ImportListIDs     []int32 `json:"importListIds"`
+	NotificationIDs   []int32 `json:"notificationIds"`
+	RestrictionIDs    []int32 `json:"restrictionIds"`
+	IndexerIDs        []int32 `json:"indexerIds"`
+	DownloadClientIDs []int32 `json:"downloadClientIds"`
+	AutoTagIDs        []int32 `json:"autoTagIds"`
+	SeriesIDs         []int32 `json:"seriesIds"`
+}
+
+// InUse returns true if any resource uses the tag
+func (t *TagDetailsResource) InUse() bool {
+	return len(t.DelayProfileIDs)+len(t.ImportListIDs)+len(t.NotificationIDs)+len(t.RestrictionIDs)+
+		len(t.IndexerIDs)+len(t.DownloadClientIDs)+len(t.AutoTagIDs)+len(t.SeriesIDs) > 0
+}
+
+// SystemResource is the response from the system status endpoint
+type SystemResource struct {
+	AppName          string    `json:"appName"`
+	InstanceName     string    `json:"instanceName"`
+	Version          string    `json:"version"`
+	BuildTime        time.Time `json:"buildTime"`
+	StartupPath      string    `json:"startupPath"`
+	AppData          string    `json:"appData"`
+	OsName           string    `json:"osName"`
+	OsVersion        string    `json:"osVersion"`
+	IsDocker         bool      `json:"isDocker"`
+	Branch           string    `json:"branch"`
+	RuntimeName      string    `json:"runtimeName"`
+	RuntimeVersion   string    `json:"runtimeVersion"`
+	StartTime        time.Time `json:"startTime"`
+	DatabaseType     string    `json:"databaseType"`
+	DatabaseVersion  string    `json:"databaseVersion"`
+	MigrationVersion int32     `json:"migrationVersion"`
+}
+
+type HealthResource struct {
+	ID      int32           `json:"id"`
+	Source  string          `json:"source"`
+	Type    HealthCheckType `json:"type"`
+	Message string          `json:"message"`
+	WikiURL string          `json:"wikiUrl"`
+}
+
+type HealthCheckType string
+
+const (
+	HealthCheckOk      HealthCheckType = "ok"
+	HealthCheckNotice  HealthCheckType = "notice"
+	HealthCheckWarning HealthCheckType = "warning"
+	HealthCheckError   HealthCheckType = "error"
+)
+
+type DiskSpaceResource struct {
+	ID         int32  `json:"id"`
+	Path       string `json:"path"`
+	Label      string `json:"label"`
+	FreeSpace  int64  `json:"freeSpace"`
+	TotalSpace int64  `json:"totalSpace"`
+}
+
+// TaskResource is a scheduled task of sonarr.
+// The interval is in minutes, the task name can be sent as command to run the task.
+type TaskResource struct {
+	ID            int32     `json:"id"`
+	Name          string    `json:"name"`
+	TaskName      string    `json:"taskName"`
+	Interval      int32     `json:"interval"`
+	LastExecution time.Time `json:"lastExecution"`
+	LastStartTime time.Time `json:"lastStartTime"`
+	NextExecution time.Time `json:"nextExecution"`
+}
+
+type BlocklistResourcePagingResource struct {
+	Page          int32                    `json:"page"`
+	PageSize      int32                    `json:"pageSize"`
+	SortKey       string                   `json:"sortKey"`
+	SortDirection httpclient.SortDirection `json:"sortDirection"`
+	Filters       []PagingResourceFilter   `json:"filters"`
+	TotalRecords  int32                    `json:"totalRecords"`
+	Records       []*BlocklistResource     `json:"records"`
+}
+
+// BlocklistResource is a release sonarr won't grab again
+type BlocklistResource struct {
+	ID            int32                  `json:"id"`
+	SeriesID      int32                  `json:"seriesId"`
+	EpisodeIDs    []int32                `json:"episodeIds"`
+	SourceTitle   string                 `json:"sourceTitle"`
+	Languages     []Language             `json:"languages"`
+	Quality       *QualityModel          `json:"quality"`
+	CustomFormats []CustomFormatResource `json:"customFormats"`
+	Date          time.Time              `json:"date"`
+	Protocol      DownloadProtocol       `json:"protocol"`
+	Indexer       string                 `json:"indexer"`
+	Message       string                 `json:"message"`
+	Series        *SeriesResource        `json:"series"`
+}
+
+type BlocklistBulkResource struct {
+	IDs []int32 `json:"ids"`
+}